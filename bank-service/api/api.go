@@ -0,0 +1,10 @@
+// Package api holds the protobuf definitions of the service and the
+// OpenAPI spec generated from them.
+package api
+
+import _ "embed"
+
+// OpenAPI is the spec of the HTTP/JSON gateway.
+//
+//go:embed bank/bank.swagger.json
+var OpenAPI []byte
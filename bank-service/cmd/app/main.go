@@ -1,11 +1,24 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/d1mitrii/money-transfer/bank-service/internal/app"
 	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
 )
 
 func main() {
 	cfg := config.MustLoad()
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(cfg, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, "migrate:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	app.Run(cfg)
 }
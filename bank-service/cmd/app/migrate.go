@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/migrations"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/pressly/goose/v3"
+)
+
+const migrateUsage = `usage: app [-config path] migrate <command>
+
+commands:
+  up          apply every pending migration
+  down        roll back the last applied migration
+  status      list the migrations and when they were applied
+  to VERSION  migrate up or down to VERSION, 0 rolls back everything`
+
+// migrate runs a migration command against the database of the config.
+func migrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	var version int64
+	switch args[0] {
+	case "up", "down", "status":
+		if len(args) != 1 {
+			return errors.New(migrateUsage)
+		}
+	case "to":
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+		v, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || v < 0 {
+			return fmt.Errorf("incorrect version %q", args[1])
+		}
+		version = v
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	if cfg.Postgres.URL == "" {
+		return errors.New("postgres url is not set")
+	}
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+
+	pg, err := postgres.New(cfg.Postgres.URL,
+		postgres.MaxPoolSize(max(cfg.Postgres.MaxPoolSize, 2)),
+		postgres.StatementTimeout(cfg.Postgres.StatementTimeout),
+	)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	migrator, err := postgres.NewMigrator(pg, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	switch args[0] {
+	case "up":
+		return report(migrator.Up(ctx))
+	case "down":
+		result, err := migrator.Down(ctx)
+		if result == nil {
+			return report(nil, err)
+		}
+		return report([]*goose.MigrationResult{result}, err)
+	case "to":
+		return report(migrator.To(ctx, version))
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tMIGRATION\tAPPLIED AT")
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if s.State == goose.StateApplied {
+			appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", s.Source.Version, s.Source.Path, appliedAt)
+	}
+	return w.Flush()
+}
+
+// report prints the applied migrations, including the ones applied before a failure.
+func report(results []*goose.MigrationResult, err error) error {
+	var partial *goose.PartialError
+	if errors.As(err, &partial) {
+		results = partial.Applied
+	}
+
+	for _, r := range results {
+		fmt.Println(r)
+	}
+	if err == nil && len(results) == 0 {
+		fmt.Println("no migrations to run")
+	}
+
+	return err
+}
@@ -0,0 +1,561 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// commands maps the command names to their implementations, one per RPC.
+// It is filled in init as the commands refer to it for their usage.
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"create":         {usage: "-name NAME [-balance N] [-owner SUBJECT]", help: "create an account", run: createAccount},
+		"get":            {usage: "ACCOUNT_UUID", help: "show an account", run: getAccount},
+		"delete":         {usage: "ACCOUNT_UUID", help: "delete an account", run: deleteAccount},
+		"deposit":        {usage: "-amount N [-transfer UUID] ACCOUNT_UUID", help: "deposit to an account", run: deposit},
+		"withdraw":       {usage: "-amount N [-transfer UUID] ACCOUNT_UUID", help: "withdraw from an account", run: withdraw},
+		"refund":         {usage: "-amount N [-transfer UUID] ACCOUNT_UUID", help: "refund to an account", run: refund},
+		"import":         {usage: "[-f FILE]", help: "import accounts from CSV rows external_id,name,balance,owner", run: importAccounts},
+		"batch":          {usage: "[-f FILE]", help: "apply a batch of postings given as JSON", run: batchPostings},
+		"transfer":       {usage: "-from UUID -to UUID -amount N [-id UUID]", help: "create a transfer", run: createTransfer},
+		"transfer-fail":  {usage: "[-status failed|compensating] [-reason TEXT] TRANSFER_UUID", help: "fail a transfer", run: failTransfer},
+		"transfer-get":   {usage: "TRANSFER_UUID", help: "show a transfer with its events", run: getTransfer},
+		"transfers":      {usage: "[-account UUID] [-status STATUS] [-page-size N] [-page-token TOKEN]", help: "list transfers", run: listTransfers},
+		"statement":      {usage: "-from TIME -to TIME [-format csv|json] [-out FILE] ACCOUNT_UUID", help: "download an account statement", run: generateStatement},
+		"reconcile":      {usage: "[-repair]", help: "reconcile the balances with the ledger", run: reconcileBalances},
+		"reconciliation": {usage: "RUN_UUID", help: "show a reconciliation run", run: getReconciliationRun},
+		"balance-at":     {usage: "-at TIME ACCOUNT_UUID", help: "show the balance of an account at a point in time", run: getBalanceAt},
+		"audit":          {usage: "[-subject S] [-method M] [-from TIME] [-to TIME] [-page-size N] [-page-token TOKEN]", help: "query the audit log", run: queryAuditLog},
+		"audit-verify":   {usage: "", help: "verify the hash chain of the audit log", run: verifyAuditLog},
+		"health":         {usage: "[-service NAME]", help: "check the serving status", run: checkHealth},
+	}
+}
+
+func createAccount(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("create")
+	name := fs.String("name", "", "account name")
+	balance := fs.Int64("balance", 0, "initial balance")
+	owner := fs.String("owner", "", "subject of the owner")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.CreateAccount(ctx, &bankv1.CreateAccountRequest{
+		Name:    *name,
+		Balance: *balance,
+		Owner:   *owner,
+	})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func getAccount(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("get")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.GetAccount(ctx, &bankv1.GetAccountRequest{AccountUUID: fs.Arg(0)})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func deleteAccount(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("delete")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.DeleteAccount(ctx, &bankv1.DeleteAccountRequest{AccountUUID: fs.Arg(0)})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func deposit(ctx context.Context, e *env, args []string) error {
+	return movement(ctx, e, "deposit", args, func(ctx context.Context, accountUUID string, amount int64, transferUUID string) (*emptypb.Empty, error) {
+		return e.bank.Deposit(ctx, &bankv1.DepositRequest{AccountUUID: accountUUID, Amount: amount, TransferUUID: transferUUID})
+	})
+}
+
+func withdraw(ctx context.Context, e *env, args []string) error {
+	return movement(ctx, e, "withdraw", args, func(ctx context.Context, accountUUID string, amount int64, transferUUID string) (*emptypb.Empty, error) {
+		return e.bank.Withdraw(ctx, &bankv1.WithdrawRequest{AccountUUID: accountUUID, Amount: amount, TransferUUID: transferUUID})
+	})
+}
+
+func refund(ctx context.Context, e *env, args []string) error {
+	return movement(ctx, e, "refund", args, func(ctx context.Context, accountUUID string, amount int64, transferUUID string) (*emptypb.Empty, error) {
+		return e.bank.Refund(ctx, &bankv1.RefundRequest{AccountUUID: accountUUID, Amount: amount, TransferUUID: transferUUID})
+	})
+}
+
+// movement runs the deposit, withdraw and refund commands, they only differ by the RPC.
+func movement(
+	ctx context.Context,
+	e *env,
+	name string,
+	args []string,
+	call func(ctx context.Context, accountUUID string, amount int64, transferUUID string) (*emptypb.Empty, error),
+) error {
+	fs := newFlagSet(name)
+	amount := fs.Int64("amount", 0, "amount to move")
+	transfer := fs.String("transfer", "", "transfer the movement advances")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	resp, err := call(ctx, fs.Arg(0), *amount, *transfer)
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func importAccounts(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("import")
+	file := fs.String("f", "-", "CSV `file`, - for stdin")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	in, closeIn, err := openInput(e, *file)
+	if err != nil {
+		return err
+	}
+	defer closeIn()
+
+	stream, err := e.bank.ImportAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// The header is optional.
+		if line == 1 && strings.EqualFold(record[0], "external_id") {
+			continue
+		}
+
+		row, err := importRow(record)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := stream.Send(row); err != nil {
+			// The server has ended the stream, its status comes with CloseAndRecv.
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func importRow(record []string) (*bankv1.ImportAccountRow, error) {
+	if len(record) < 3 || len(record) > 4 {
+		return nil, fmt.Errorf("want external_id,name,balance[,owner], got %d fields", len(record))
+	}
+
+	balance, err := strconv.ParseInt(record[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect balance %q", record[2])
+	}
+
+	row := &bankv1.ImportAccountRow{
+		ExternalID: record[0],
+		Name:       record[1],
+		Balance:    balance,
+	}
+	if len(record) == 4 {
+		row.Owner = record[3]
+	}
+
+	return row, nil
+}
+
+func batchPostings(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("batch")
+	file := fs.String("f", "-", "JSON `file` with a BatchPostingsRequest, - for stdin")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	var req bankv1.BatchPostingsRequest
+	if err := readJSON(e, *file, &req); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.BatchPostings(ctx, &req)
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func createTransfer(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("transfer")
+	id := fs.String("id", "", "transfer UUID, makes a repeated call return the existing transfer")
+	from := fs.String("from", "", "account to debit")
+	to := fs.String("to", "", "account to credit")
+	amount := fs.Int64("amount", 0, "amount to transfer")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.CreateTransfer(ctx, &bankv1.CreateTransferRequest{
+		TransferUUID:    *id,
+		FromAccountUUID: *from,
+		ToAccountUUID:   *to,
+		Amount:          *amount,
+	})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func failTransfer(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("transfer-fail")
+	statusName := fs.String("status", "failed", "failed or compensating")
+	reason := fs.String("reason", "", "why the transfer failed")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	st, err := transferStatus(*statusName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.bank.FailTransfer(ctx, &bankv1.FailTransferRequest{
+		TransferUUID: fs.Arg(0),
+		Status:       st,
+		Reason:       *reason,
+	})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func getTransfer(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("transfer-get")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.GetTransfer(ctx, &bankv1.GetTransferRequest{TransferUUID: fs.Arg(0)})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func listTransfers(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("transfers")
+	account := fs.String("account", "", "only transfers from or to the account")
+	statusName := fs.String("status", "", "only transfers in the status, e.g. pending")
+	pageSize := fs.Int("page-size", 0, "transfers per page")
+	pageToken := fs.String("page-token", "", "token of the page to return")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	req := &bankv1.ListTransfersRequest{
+		AccountUUID: *account,
+		PageSize:    int32(*pageSize),
+		PageToken:   *pageToken,
+	}
+	if *statusName != "" {
+		st, err := transferStatus(*statusName)
+		if err != nil {
+			return err
+		}
+		req.Status = st
+	}
+
+	resp, err := e.bank.ListTransfers(ctx, req)
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+// generateStatement writes the statement document as is, the output format does not apply to it.
+func generateStatement(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("statement")
+	from := fs.String("from", "", "start of the period, RFC 3339")
+	to := fs.String("to", "", "end of the period, RFC 3339, exclusive")
+	format := fs.String("format", "csv", "csv or json")
+	file := fs.String("out", "-", "`file` to write, - for stdout")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	req := &bankv1.GenerateStatementRequest{AccountUUID: fs.Arg(0)}
+
+	var err error
+	if req.From, err = parseTime("from", *from); err != nil {
+		return err
+	}
+	if req.To, err = parseTime("to", *to); err != nil {
+		return err
+	}
+
+	value, ok := bankv1.StatementFormat_value["STATEMENT_FORMAT_"+strings.ToUpper(*format)]
+	if !ok {
+		return fmt.Errorf("unknown statement format %q", *format)
+	}
+	req.Format = bankv1.StatementFormat(value)
+
+	stream, err := e.bank.GenerateStatement(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	out := e.stdout
+	if *file != "-" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(chunk.GetData()); err != nil {
+			return err
+		}
+	}
+}
+
+func reconcileBalances(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("reconcile")
+	repair := fs.Bool("repair", false, "reset mismatched balances to the ledger ones")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.ReconcileBalances(ctx, &bankv1.ReconcileBalancesRequest{Repair: *repair})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func getReconciliationRun(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("reconciliation")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.GetReconciliationRun(ctx, &bankv1.GetReconciliationRunRequest{RunUUID: fs.Arg(0)})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func getBalanceAt(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("balance-at")
+	at := fs.String("at", "", "point in time, RFC 3339")
+	if err := parseArgs(fs, args, 1); err != nil {
+		return err
+	}
+
+	ts, err := parseTime("at", *at)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.bank.GetBalanceAt(ctx, &bankv1.GetBalanceAtRequest{AccountUUID: fs.Arg(0), At: ts})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func queryAuditLog(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("audit")
+	subject := fs.String("subject", "", "only calls of the subject")
+	method := fs.String("method", "", "only calls of the full method name")
+	from := fs.String("from", "", "only calls made since, RFC 3339")
+	to := fs.String("to", "", "only calls made before, RFC 3339")
+	pageSize := fs.Int("page-size", 0, "entries per page")
+	pageToken := fs.String("page-token", "", "token of the page to return")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	req := &bankv1.QueryAuditLogRequest{
+		Subject:   *subject,
+		Method:    *method,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	}
+
+	var err error
+	if *from != "" {
+		if req.From, err = parseTime("from", *from); err != nil {
+			return err
+		}
+	}
+	if *to != "" {
+		if req.To, err = parseTime("to", *to); err != nil {
+			return err
+		}
+	}
+
+	resp, err := e.bank.QueryAuditLog(ctx, req)
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func verifyAuditLog(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("audit-verify")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := e.bank.VerifyAuditLog(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func checkHealth(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet("health")
+	service := fs.String("service", "", "service name, the whole server by default")
+	if err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := e.health.Check(ctx, &healthv1.HealthCheckRequest{Service: *service})
+	if err != nil {
+		return err
+	}
+	return e.out.print(resp)
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: bankctl %s %s\n\n%s\n\n", name, commands[name].usage, commands[name].help)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs parses the command flags and checks the number of positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string, want int) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != want {
+		fs.Usage()
+		return fmt.Errorf("%s: want %d arguments, got %d", fs.Name(), want, fs.NArg())
+	}
+	return nil
+}
+
+func parseTime(name, value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, fmt.Errorf("-%s is required", name)
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("-%s: %w", name, err)
+	}
+	return timestamppb.New(t), nil
+}
+
+// transferStatus accepts the status either by its short name, like pending, or in full.
+func transferStatus(name string) (bankv1.TransferStatus, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "TRANSFER_STATUS_") {
+		name = "TRANSFER_STATUS_" + name
+	}
+	value, ok := bankv1.TransferStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown transfer status %q", name)
+	}
+	return bankv1.TransferStatus(value), nil
+}
+
+func openInput(e *env, file string) (io.Reader, func(), error) {
+	if file == "-" {
+		return e.stdin, func() {}, nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { f.Close() }, nil
+}
+
+func readJSON(e *env, file string, msg proto.Message) error {
+	in, closeIn, err := openInput(e, file)
+	if err != nil {
+		return err
+	}
+	defer closeIn()
+
+	raw, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		return fmt.Errorf("parse %s: %w", file, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	_defaultAddress = "localhost:9090"
+	_defaultTimeout = 30 * time.Second
+)
+
+// Config holds the connection profiles, one of them is current unless -profile is given.
+type Config struct {
+	Current  string             `yaml:"current"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+type Profile struct {
+	Address string        `yaml:"address"`
+	Output  string        `yaml:"output"`
+	Timeout time.Duration `yaml:"timeout"`
+	Token   string        `yaml:"token"`
+	APIKey  string        `yaml:"api_key"`
+	TLS     TLSProfile    `yaml:"tls"`
+}
+
+// TLSProfile enables TLS when Enabled or a CA file is set, the system roots are used without a CA file.
+type TLSProfile struct {
+	Enabled    bool   `yaml:"enabled"`
+	CAFile     string `yaml:"ca_file"`
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	ServerName string `yaml:"server_name"`
+}
+
+// configPath is $BANKCTL_CONFIG or bankctl/config.yaml in the user config directory.
+func configPath() string {
+	if path := os.Getenv("BANKCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "bankctl", "config.yaml")
+}
+
+// loadProfile reads the named profile, or the current one if name is empty.
+// A missing config file is not an error, the defaults are used then.
+func loadProfile(path, name string) (Profile, error) {
+	profile := Profile{
+		Address: _defaultAddress,
+		Output:  "table",
+		Timeout: _defaultTimeout,
+	}
+	if path == "" {
+		return profile, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && name == "" {
+			return profile, nil
+		}
+		return Profile{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return Profile{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	if name == "" {
+		name = cfg.Current
+	}
+	if name == "" {
+		return profile, nil
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+
+	if p.Address != "" {
+		profile.Address = p.Address
+	}
+	if p.Output != "" {
+		profile.Output = p.Output
+	}
+	if p.Timeout > 0 {
+		profile.Timeout = p.Timeout
+	}
+	profile.Token = p.Token
+	profile.APIKey = p.APIKey
+	profile.TLS = p.TLS
+
+	return profile, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func dial(p Profile) (*grpc.ClientConn, error) {
+	creds, err := transportCredentials(p.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if p.Token != "" || p.APIKey != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(callCredentials{
+			token:  p.Token,
+			apiKey: p.APIKey,
+			secure: p.TLS.Enabled || p.TLS.CAFile != "",
+		}))
+	}
+
+	return grpc.NewClient(p.Address, opts...)
+}
+
+func transportCredentials(p TLSProfile) (credentials.TransportCredentials, error) {
+	if !p.Enabled && p.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: p.ServerName,
+	}
+
+	if p.CAFile != "" {
+		pem, err := os.ReadFile(p.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file: %w", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", p.CAFile)
+		}
+		cfg.RootCAs = roots
+	}
+
+	if p.CertFile != "" || p.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(p.CertFile, p.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
+
+// callCredentials sends the bearer token and the API key with every call.
+type callCredentials struct {
+	token  string
+	apiKey string
+	secure bool
+}
+
+func (c callCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	md := make(map[string]string, 2)
+	if c.token != "" {
+		md["authorization"] = "Bearer " + c.token
+	}
+	if c.apiKey != "" {
+		md["x-api-key"] = c.apiKey
+	}
+	return md, nil
+}
+
+// RequireTransportSecurity lets credentials go over plain connections too,
+// the local setup runs without TLS.
+func (c callCredentials) RequireTransportSecurity() bool {
+	return false
+}
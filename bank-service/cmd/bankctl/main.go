@@ -0,0 +1,190 @@
+// Command bankctl calls the bank service from the command line.
+//
+// Connection settings come from a profile in the config file, see Config,
+// and may be overridden by the global flags:
+//
+//	bankctl [global flags] <command> [command flags] [args]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// env is what a command runs with.
+type env struct {
+	bank   bankv1.BankClient
+	health healthv1.HealthClient
+	out    *printer
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+type command struct {
+	usage string
+	help  string
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		if st, ok := status.FromError(err); ok {
+			printStatus(os.Stderr, st)
+		} else {
+			fmt.Fprintf(os.Stderr, "bankctl: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("bankctl", flag.ContinueOnError)
+	fs.Usage = func() { usage(fs) }
+
+	var (
+		configFile = fs.String("config", configPath(), "config `file` with the profiles")
+		profile    = fs.String("profile", os.Getenv("BANKCTL_PROFILE"), "profile `name`, the current one of the config by default")
+		addr       = fs.String("addr", "", "server `address`")
+		output     = fs.String("o", "", "output `format`, table or json")
+		timeout    = fs.Duration("timeout", 0, "call timeout")
+		token      = fs.String("token", "", "bearer `token`")
+		apiKey     = fs.String("api-key", "", "API `key`")
+		useTLS     = fs.Bool("tls", false, "connect over TLS")
+		caFile     = fs.String("ca", "", "CA certificate `file`, enables TLS")
+		certFile   = fs.String("cert", "", "client certificate `file`")
+		keyFile    = fs.String("key", "", "client key `file`")
+		serverName = fs.String("server-name", "", "server `name` to verify the certificate against")
+		primary    = fs.Bool("read-your-writes", false, "read from the primary, which has the writes of the previous commands")
+	)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no command given")
+	}
+
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %q, run bankctl -h for the list", name)
+	}
+
+	p, err := loadProfile(*configFile, *profile)
+	if err != nil {
+		return err
+	}
+
+	// Flags given explicitly take precedence over the profile.
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "addr":
+			p.Address = *addr
+		case "o":
+			p.Output = *output
+		case "timeout":
+			p.Timeout = *timeout
+		case "token":
+			p.Token = *token
+		case "api-key":
+			p.APIKey = *apiKey
+		case "tls":
+			p.TLS.Enabled = *useTLS
+		case "ca":
+			p.TLS.CAFile = *caFile
+		case "cert":
+			p.TLS.CertFile = *certFile
+		case "key":
+			p.TLS.KeyFile = *keyFile
+		case "server-name":
+			p.TLS.ServerName = *serverName
+		}
+	})
+
+	out, err := newPrinter(os.Stdout, p.Output)
+	if err != nil {
+		return err
+	}
+
+	conn, err := dial(p)
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", p.Address, err)
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	if *primary {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-read-your-writes", "true")
+	}
+
+	return cmd.run(ctx, &env{
+		bank:   bankv1.NewBankClient(conn),
+		health: healthv1.NewHealthClient(conn),
+		out:    out,
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+	}, fs.Args()[1:])
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintf(w, "Usage: bankctl [global flags] <command> [command flags] [args]\n\nCommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-20s %s\n", name, commands[name].help)
+	}
+
+	fmt.Fprintf(w, "\nGlobal flags:\n")
+	fs.PrintDefaults()
+	fmt.Fprintf(w, "\nRun bankctl <command> -h for the flags of a command.\n")
+}
+
+// printStatus prints the code and message of a failed call with its details,
+// the reason and the violations tell what to fix.
+func printStatus(w io.Writer, st *status.Status) {
+	fmt.Fprintf(w, "bankctl: %s: %s\n", st.Code(), st.Message())
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			fmt.Fprintf(w, "  reason: %s\n", d.GetReason())
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				fmt.Fprintf(w, "  %s: %s\n", v.GetField(), v.GetDescription())
+			}
+		case *errdetails.PreconditionFailure:
+			for _, v := range d.GetViolations() {
+				fmt.Fprintf(w, "  %s %s: %s\n", v.GetType(), v.GetSubject(), v.GetDescription())
+			}
+		case *errdetails.RetryInfo:
+			fmt.Fprintf(w, "  retry after %s\n", d.GetRetryDelay().AsDuration())
+		}
+	}
+}
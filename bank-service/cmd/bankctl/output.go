@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	if format != outputTable && format != outputJSON {
+		return nil, fmt.Errorf("unknown output format %q, want %s or %s", format, outputTable, outputJSON)
+	}
+	return &printer{w: w, format: format}, nil
+}
+
+func (p *printer) print(msg proto.Message) error {
+	if p.format == outputJSON {
+		b, err := protojson.MarshalOptions{
+			Multiline:       true,
+			EmitUnpopulated: true,
+		}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(p.w, string(b))
+		return err
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	printTable(tw, msg.ProtoReflect())
+	return tw.Flush()
+}
+
+// printTable prints a message as key/value lines. Lists of messages, like
+// the transfers of a page, are printed as tables below the other fields.
+func printTable(w io.Writer, m protoreflect.Message) {
+	var lists []protoreflect.FieldDescriptor
+
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() && fd.Message() != nil {
+			lists = append(lists, fd)
+			continue
+		}
+		fmt.Fprintf(w, "%s:\t%s\n", fd.Name(), formatValue(fd, m.Get(fd)))
+	}
+
+	for _, fd := range lists {
+		list := m.Get(fd).List()
+		fmt.Fprintf(w, "\n%s (%d)\n", fd.Name(), list.Len())
+		if list.Len() == 0 {
+			continue
+		}
+
+		columns := fd.Message().Fields()
+		header := make([]string, columns.Len())
+		for i := range header {
+			header[i] = strings.ToUpper(string(columns.Get(i).Name()))
+		}
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+
+		for i := 0; i < list.Len(); i++ {
+			row := list.Get(i).Message()
+			cells := make([]string, columns.Len())
+			for j := range cells {
+				cells[j] = formatValue(columns.Get(j), row.Get(columns.Get(j)))
+			}
+			fmt.Fprintln(w, strings.Join(cells, "\t"))
+		}
+	}
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		items := make([]string, list.Len())
+		for i := range items {
+			items[i] = formatScalar(fd, list.Get(i))
+		}
+		return strings.Join(items, ",")
+	case fd.IsMap():
+		return fmt.Sprintf("%d entries", v.Map().Len())
+	}
+	return formatScalar(fd, v)
+}
+
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return fmt.Sprint(v.Enum())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%d bytes", len(v.Bytes()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message()
+		if !msg.IsValid() {
+			return "-"
+		}
+		if ts, ok := msg.Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime().Format("2006-01-02T15:04:05Z07:00")
+		}
+		b, err := protojson.Marshal(msg.Interface())
+		if err != nil {
+			return "?"
+		}
+		return string(b)
+	}
+	return v.String()
+}
@@ -2,56 +2,310 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"os/signal"
 	"syscall"
+	"time"
 
+	gatewayapp "github.com/d1mitrii/money-transfer/bank-service/internal/app/gateway"
 	grpcapp "github.com/d1mitrii/money-transfer/bank-service/internal/app/grpc"
+	httpapp "github.com/d1mitrii/money-transfer/bank-service/internal/app/http"
+	jobsapp "github.com/d1mitrii/money-transfer/bank-service/internal/app/jobs"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/audit"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/authz"
 	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
-	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/pgdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/deadline"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/health"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/metrics"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/ratelimit"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/auditlog"
 	"github.com/d1mitrii/money-transfer/bank-service/internal/services/bank"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/reconciliation"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/snapshot"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/statement"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/transfer"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/certreloader"
 	"github.com/d1mitrii/money-transfer/bank-service/pkg/logger"
-	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/tracing"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/test/bufconn"
 )
 
+const _gatewayBufferSize = 1 << 20
+
 func Run(cfg *config.Config) {
 	const op = "app - Run"
 
 	// Logger
 	log := logger.SetupLogger("local")
-	log.Info(cfg.Postgres.URL)
 
-	// Database
-	pg, err := postgres.New(cfg.Postgres.URL, postgres.MaxPoolSize(cfg.Postgres.MaxPoolSize))
+	// Tracing
+	tp, err := tracing.New(context.Background(), "bank-service",
+		tracing.Exporter(cfg.Tracing.Exporter),
+		tracing.Endpoint(cfg.Tracing.Endpoint),
+		tracing.Insecure(cfg.Tracing.Insecure),
+		tracing.File(cfg.Tracing.File),
+		tracing.SampleRatio(cfg.Tracing.SampleRatio),
+	)
 	if err != nil {
-		log.Error(fmt.Sprintf("%s - postgres.New: %v", op, err))
+		log.Error(fmt.Sprintf("%s - tracing.New: %v", op, err))
 		return
 	}
-	defer pg.Close()
+	defer func() {
+		if err := tp.Shutdown(context.Background()); err != nil {
+			log.Error(fmt.Sprintf("%s - tp.Shutdown: %v", op, err))
+		}
+	}()
+
+	// Metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	grpcMetrics := grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram())
+	registry.MustRegister(grpcMetrics)
+	bankMetrics := metrics.New(registry)
 
-	// Repositories
-	bankRepo := pgdb.New(pg)
+	// Storage
+	store, err := newStorage(log, cfg, registry)
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - newStorage: %v", op, err))
+		return
+	}
+	defer store.close()
 
 	// Services
+	al := auditlog.New(
+		log,
+		store.audit,
+	)
+
+	// Units of work of audited streams are recorded along with their changes.
+	var auditor *audit.Auditor
+	bankTx := bank.TxManager(store.tx)
+	if cfg.Audit.Enabled {
+		auditor = audit.New(log, al, store.tx)
+		bankTx = auditor.WrapTxManager(store.tx)
+	}
+
 	b := bank.New(
 		log,
-		bankRepo,
-		bankRepo,
+		store.bank,
+		store.bank,
+		bankTx,
+	)
+	t := transfer.New(
+		log,
+		store.transfers,
+	)
+	s := statement.New(
+		log,
+		store.bank,
+	)
+	r := reconciliation.New(
+		log,
+		store.reconciliation,
+		cfg.Reconciliation.AllowRepair,
+	)
+	sn := snapshot.New(
+		log,
+		store.bank,
 	)
 
 	// grpc server
-	grpcApp := grpcapp.New(log, b, cfg.GRPC.Port)
+	// Health
+	healthServer := grpchealth.NewServer()
+	checker := health.New(log, store.db, healthServer, cfg.Health.CheckInterval, cfg.Health.CheckTimeout)
+
+	deadlines, err := deadline.New(cfg.GRPC.Timeout, cfg.GRPC.MethodTimeouts)
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - deadline.New: %v", op, err))
+		return
+	}
+
+	grpcOpts := []grpcapp.Option{
+		grpcapp.WithMetrics(grpcMetrics),
+		grpcapp.WithTracing(),
+		grpcapp.WithHealth(healthServer),
+		grpcapp.WithDeadlines(deadlines),
+		grpcapp.WithReadYourWrites(),
+	}
+	if cfg.GRPC.Reflection {
+		grpcOpts = append(grpcOpts, grpcapp.WithReflection())
+	}
+	var tlsConfig *tls.Config
+	if cfg.GRPC.TLS.CertFile != "" {
+		tlsConfig, err = serverTLS(log, cfg.GRPC.TLS)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - serverTLS: %v", op, err))
+			return
+		}
+		grpcOpts = append(grpcOpts, grpcapp.WithTLS(tlsConfig))
+	} else {
+		log.Warn("tls is disabled")
+	}
+
+	if cfg.Auth.Enabled {
+		authenticator, err := auth.New(log, cfg.Auth)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - auth.New: %v", op, err))
+			return
+		}
+		grpcOpts = append(grpcOpts, grpcapp.WithAuth(authenticator))
+
+		// Authenticated callers would otherwise all be allowed everything.
+		if cfg.Authz.PolicyFile == "" {
+			log.Error(fmt.Sprintf("%s: authentication is enabled without an authorization policy", op))
+			return
+		}
+		policy, err := authz.LoadPolicy(cfg.Authz.PolicyFile)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - authz.LoadPolicy: %v", op, err))
+			return
+		}
+		authorizer, err := authz.New(log, policy, store.bank)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - authz.New: %v", op, err))
+			return
+		}
+		grpcOpts = append(grpcOpts, grpcapp.WithAuthz(authorizer))
+	} else {
+		log.Warn("authentication is disabled")
+	}
+
+	if auditor != nil {
+		grpcOpts = append(grpcOpts, grpcapp.WithAudit(auditor))
+		if cfg.Storage.Backend == _backendPostgres && cfg.Postgres.DepositBatchWindow > 0 {
+			log.Warn("deposits are not batched, they are written along with their audit entries")
+		}
+	} else {
+		log.Warn("audit log is disabled")
+	}
+
+	// The gateway calls the gRPC server through an in-memory connection.
+	var gatewayListener *bufconn.Listener
+	if cfg.Gateway.Enabled {
+		// The gateway forwards credentials, they are not sent in the clear.
+		if cfg.Auth.Enabled && tlsConfig == nil {
+			log.Error(fmt.Sprintf("%s: the gateway needs tls while authentication is enabled", op))
+			return
+		}
+		gatewayListener = bufconn.Listen(_gatewayBufferSize)
+		grpcOpts = append(grpcOpts, grpcapp.WithInProcess(gatewayListener))
+	}
+
+	if cfg.RateLimit.Enabled {
+		limiter, err := ratelimit.New(log, cfg.RateLimit)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - ratelimit.New: %v", op, err))
+			return
+		}
+		grpcOpts = append(grpcOpts, grpcapp.WithRateLimit(limiter))
+	}
+
+	grpcApp := grpcapp.New(log, metrics.InstrumentBank(b, bankMetrics, store.tx), t, s, r, sn, al, cfg.GRPC.Port, grpcOpts...)
+
+	// http gateway
+	var gatewayApp *gatewayapp.App
+	if cfg.Gateway.Enabled {
+		gatewayApp, err = gatewayapp.New(log, cfg.Gateway.Port, gatewayListener, tlsConfig)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - gatewayapp.New: %v", op, err))
+			return
+		}
+	}
+
+	// http server
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle("GET /livez", checker.LivenessHandler())
+	mux.Handle("GET /readyz", checker.ReadinessHandler())
+	httpApp := httpapp.New(log, cfg.HTTP.Port, mux)
+
+	// Scheduled jobs
+	jobsApp := jobsapp.New(log,
+		jobsapp.Job{
+			Name:     "reconciliation",
+			Interval: cfg.Reconciliation.Interval,
+			Run: func(ctx context.Context) error {
+				_, err := r.Reconcile(ctx, models.ReconciliationScheduled, cfg.Reconciliation.Repair)
+				return err
+			},
+		},
+		jobsapp.Job{
+			Name:     "balance snapshots",
+			Interval: cfg.Snapshots.Interval,
+			Run:      sn.SnapshotBalances,
+		},
+	)
 
 	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGKILL)
 	defer done()
 
 	// Run apps
-	g, _ := errgroup.WithContext(ctx)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(checker.Run)
 	g.Go(grpcApp.Run)
+	g.Go(jobsApp.Run)
+	g.Go(httpApp.Run)
+	if gatewayApp != nil {
+		g.Go(gatewayApp.Run)
+	}
+
+	// Graceful shutdown, on a signal or once any of the apps fails
+	g.Go(func() error {
+		<-gctx.Done()
+		log.Info(fmt.Sprintf("%s - shutting down", op))
+
+		checker.Shutdown()
+		time.Sleep(cfg.Health.ShutdownDelay)
+
+		jobsApp.Stop()
+		if gatewayApp != nil {
+			gatewayApp.Stop()
+		}
+		grpcApp.Stop()
+		httpApp.Stop()
+		checker.Stop()
+
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		log.Error(fmt.Sprintf("%s - g.Wait: %v", op, err))
+	}
+}
 
-	g.Wait()
+func serverTLS(log *slog.Logger, cfg config.TLSConfig) (*tls.Config, error) {
+	reloader, err := certreloader.New(cfg.CertFile, cfg.KeyFile,
+		certreloader.ClientCA(cfg.ClientCAFile),
+		certreloader.Interval(cfg.ReloadInterval),
+		certreloader.OnError(func(err error) {
+			log.Error("failed to reload certificates", slog.Any("err", err))
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth := tls.NoClientCert
+	switch {
+	case cfg.RequireClientCert:
+		clientAuth = tls.RequireAndVerifyClientCert
+	case cfg.ClientCAFile != "":
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
 
-	// Graceful shutdown
-	grpcApp.Stop()
+	return reloader.ServerConfig(clientAuth)
 }
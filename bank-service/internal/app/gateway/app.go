@@ -0,0 +1,129 @@
+package gatewayapp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/textproto"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/api"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	_readHeaderTimeout = 5 * time.Second
+	_shutdownTimeout   = 10 * time.Second
+)
+
+// Headers forwarded to the gRPC server besides Authorization, which always is.
+var forwardedHeaders = map[string]string{
+	"X-Api-Key":          "x-api-key",
+	"X-Read-Your-Writes": "x-read-your-writes",
+}
+
+// App serves every Bank RPC as HTTP/JSON by calling the gRPC server,
+// so the calls go through the same interceptors as the gRPC ones. The gRPC
+// server sees the address of the HTTP client as the last X-Forwarded-For one.
+type App struct {
+	log    *slog.Logger
+	server *http.Server
+	conn   *grpc.ClientConn
+	port   int
+}
+
+// New connects the gateway to the gRPC server listening on lis. The gateway
+// serves TLS with tlsConfig, or plain HTTP if it is nil.
+func New(log *slog.Logger, port int, lis *bufconn.Listener, tlsConfig *tls.Config) (*App, error) {
+	const op = "gatewayapp.New"
+
+	conn, err := grpc.NewClient("passthrough:///in-process",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s - grpc.NewClient: %w", op, err)
+	}
+
+	gw := runtime.NewServeMux(
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if md, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+				return md, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
+	if err := bankv1.RegisterBankHandler(context.Background(), gw, conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("%s - bankv1.RegisterBankHandler: %w", op, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gw)
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(api.OpenAPI)
+	})
+
+	return &App{
+		log: log,
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: _readHeaderTimeout,
+			TLSConfig:         tlsConfig,
+		},
+		conn: conn,
+		port: port,
+	}, nil
+}
+
+func (a *App) Run() error {
+	const op = "gatewayapp.App.Run"
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", a.port))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.log.Info("http gateway started",
+		slog.Any("address", l.Addr().String()),
+		slog.Bool("tls", a.server.TLSConfig != nil),
+	)
+
+	serve := a.server.Serve
+	if a.server.TLSConfig != nil {
+		// The certificates come from the config.
+		serve = func(l net.Listener) error { return a.server.ServeTLS(l, "", "") }
+	}
+	if err := serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *App) Stop() {
+	const op = "gatewayapp.App.Stop"
+	a.log.Info(fmt.Sprintf("%s - stopping http gateway", op))
+
+	ctx, cancel := context.WithTimeout(context.Background(), _shutdownTimeout)
+	defer cancel()
+
+	if err := a.server.Shutdown(ctx); err != nil {
+		a.log.Error(fmt.Sprintf("%s - a.server.Shutdown: %v", op, err))
+	}
+	if err := a.conn.Close(); err != nil {
+		a.log.Error(fmt.Sprintf("%s - a.conn.Close: %v", op, err))
+	}
+}
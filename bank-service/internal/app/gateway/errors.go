@@ -0,0 +1,39 @@
+package gatewayapp
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorHandler writes every error as a google.rpc.Status JSON body, whose code
+// and details come from the gRPC status the handlers map service errors to.
+// The HTTP status is derived from the gRPC code, and rate limited calls get
+// a Retry-After header.
+func errorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	st := status.Convert(err)
+
+	if st.Code() == codes.ResourceExhausted {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				seconds := int64(math.Ceil(info.GetRetryDelay().AsDuration().Seconds()))
+				w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			}
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, st.Err())
+}
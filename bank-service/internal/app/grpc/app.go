@@ -6,11 +6,19 @@ import (
 	"log/slog"
 	"net"
 
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/consistency"
 	bankgrpc "github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/bank"
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
@@ -18,13 +26,29 @@ type App struct {
 	log        *slog.Logger
 	gRPCServer *grpc.Server
 	port       int
+
+	// inProcessServer serves the in-process listener, it has the same
+	// services and interceptors as gRPCServer but no transport credentials.
+	inProcessServer *grpc.Server
+	inProcess       net.Listener
 }
 
 func New(
 	log *slog.Logger,
 	bankService bankgrpc.Bank,
+	transferService bankgrpc.Transfers,
+	statementService bankgrpc.Statements,
+	reconciliationService bankgrpc.Reconciliation,
+	snapshotService bankgrpc.Snapshots,
+	auditService bankgrpc.AuditLog,
 	port int,
+	opts ...Option,
 ) *App {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	logOpts := []logging.Option{
 		logging.WithLogOnEvents(
 			logging.PayloadReceived,
@@ -39,18 +63,128 @@ func New(
 		}),
 	}
 
-	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		recovery.UnaryServerInterceptor(recoveryOpts...),
-		logging.UnaryServerInterceptor(InterceptorLogger(log), logOpts...),
-	))
+	// Streams may carry a lot of messages, so only their start and end are logged.
+	streamLogOpts := []logging.Option{
+		logging.WithLogOnEvents(
+			logging.StartCall,
+			logging.FinishCall,
+		),
+	}
+
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	// Metrics come first to count the calls rejected by any other interceptor,
+	// and panics recovered into errors.
+	if o.metrics != nil {
+		unary = append(unary, o.metrics.UnaryServerInterceptor())
+		stream = append(stream, o.metrics.StreamServerInterceptor())
+	}
+
+	unary = append(unary, recovery.UnaryServerInterceptor(recoveryOpts...))
+	stream = append(stream, recovery.StreamServerInterceptor(recoveryOpts...))
+
+	if o.deadlines != nil {
+		unary = append(unary, o.deadlines.UnaryServerInterceptor())
+		stream = append(stream, o.deadlines.StreamServerInterceptor())
+	}
+
+	if o.consistency {
+		unary = append(unary, consistency.UnaryServerInterceptor())
+		stream = append(stream, consistency.StreamServerInterceptor())
+	}
+
+	unary = append(unary, logging.UnaryServerInterceptor(InterceptorLogger(log), logOpts...))
+	stream = append(stream, logging.StreamServerInterceptor(InterceptorLogger(log), streamLogOpts...))
+
+	// Interceptors of authenticated callers skip the public services.
+	requiresAuth := selector.MatchFunc(auth.RequiresAuth)
 
-	bankgrpc.Register(server, bankService)
+	if o.authenticator != nil {
+		unary = append(unary, selector.UnaryServerInterceptor(
+			grpcauth.UnaryServerInterceptor(o.authenticator.Authenticate), requiresAuth,
+		))
+		stream = append(stream, selector.StreamServerInterceptor(
+			grpcauth.StreamServerInterceptor(o.authenticator.Authenticate), requiresAuth,
+		))
+	}
 
-	return &App{
-		gRPCServer: server,
+	if o.limiter != nil {
+		unary = append(unary, o.limiter.UnaryServerInterceptor())
+		stream = append(stream, o.limiter.StreamServerInterceptor())
+	}
+
+	if o.authenticator != nil && o.authorizer != nil {
+		unary = append(unary, selector.UnaryServerInterceptor(
+			o.authorizer.UnaryServerInterceptor(), requiresAuth,
+		))
+		stream = append(stream, selector.StreamServerInterceptor(
+			o.authorizer.StreamServerInterceptor(), requiresAuth,
+		))
+	}
+
+	// The unit of work recording the calls along with their changes is the
+	// last, so calls rejected by the limits and policy take none.
+	if o.auditor != nil {
+		unary = append(unary, o.auditor.UnaryServerInterceptor())
+		stream = append(stream, o.auditor.StreamServerInterceptor())
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if o.tracing {
+		serverOpts = append(serverOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	}
+
+	newServer := func(opts ...grpc.ServerOption) *grpc.Server {
+		server := grpc.NewServer(append(serverOpts, opts...)...)
+
+		bankgrpc.Register(
+			server,
+			bankService,
+			transferService,
+			statementService,
+			reconciliationService,
+			snapshotService,
+			auditService,
+		)
+
+		if o.health != nil {
+			healthv1.RegisterHealthServer(server, o.health)
+		}
+
+		if o.reflection {
+			reflection.Register(server)
+		}
+
+		if o.metrics != nil {
+			o.metrics.InitializeMetrics(server)
+		}
+
+		return server
+	}
+
+	var creds []grpc.ServerOption
+	if o.tls != nil {
+		creds = append(creds, grpc.Creds(credentials.NewTLS(o.tls)))
+	}
+
+	a := &App{
+		gRPCServer: newServer(creds...),
 		log:        log,
 		port:       port,
 	}
+
+	if o.inProcess != nil {
+		a.inProcessServer = newServer()
+		a.inProcess = o.inProcess
+	}
+
+	return a
 }
 
 func InterceptorLogger(l *slog.Logger) logging.Logger {
@@ -69,10 +203,23 @@ func (a *App) Run() error {
 
 	a.log.Info("grpc server started", slog.Any("address", l.Addr().String()))
 
+	errCh := make(chan error, 1)
+	if a.inProcessServer != nil {
+		go func() {
+			errCh <- a.inProcessServer.Serve(a.inProcess)
+		}()
+	}
+
 	if err := a.gRPCServer.Serve(l); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if a.inProcessServer != nil {
+		if err := <-errCh; err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	return nil
 }
 
@@ -80,4 +227,7 @@ func (a *App) Stop() {
 	const op = "grpcapp.App.Stop"
 	a.log.Info(fmt.Sprintf("%s - stopping gRPC server", op))
 	a.gRPCServer.GracefulStop()
+	if a.inProcessServer != nil {
+		a.inProcessServer.GracefulStop()
+	}
 }
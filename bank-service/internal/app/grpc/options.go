@@ -0,0 +1,118 @@
+package grpcapp
+
+import (
+	"crypto/tls"
+	"net"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/audit"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/authz"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/deadline"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/ratelimit"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"google.golang.org/grpc/health"
+)
+
+type Option func(*options)
+
+type options struct {
+	authenticator *auth.Authenticator
+	authorizer    *authz.Authorizer
+	tls           *tls.Config
+	limiter       *ratelimit.Limiter
+	auditor       *audit.Auditor
+	metrics       *grpcprom.ServerMetrics
+	tracing       bool
+	health        *health.Server
+	inProcess     net.Listener
+	reflection    bool
+	deadlines     *deadline.Enforcer
+	consistency   bool
+}
+
+// WithAuth requires every call, except the public ones, to be authenticated.
+func WithAuth(authenticator *auth.Authenticator) Option {
+	return func(o *options) {
+		o.authenticator = authenticator
+	}
+}
+
+// WithAuthz enforces the role policy on authenticated calls, it requires WithAuth.
+func WithAuthz(authorizer *authz.Authorizer) Option {
+	return func(o *options) {
+		o.authorizer = authorizer
+	}
+}
+
+// WithTLS serves gRPC over TLS.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tls = cfg
+	}
+}
+
+// WithRateLimit limits the calls per caller, which is identified by WithAuth if set.
+func WithRateLimit(limiter *ratelimit.Limiter) Option {
+	return func(o *options) {
+		o.limiter = limiter
+	}
+}
+
+// WithAudit records the state-changing calls that pass the limits and policy.
+func WithAudit(auditor *audit.Auditor) Option {
+	return func(o *options) {
+		o.auditor = auditor
+	}
+}
+
+// WithMetrics counts the calls by method and code and measures their latency.
+func WithMetrics(metrics *grpcprom.ServerMetrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// WithTracing makes a span of every call, continuing the trace of the caller.
+func WithTracing() Option {
+	return func(o *options) {
+		o.tracing = true
+	}
+}
+
+// WithHealth serves the standard health checking service with the given statuses.
+func WithHealth(server *health.Server) Option {
+	return func(o *options) {
+		o.health = server
+	}
+}
+
+// WithInProcess also serves the listener, without TLS, for clients running in
+// the process like the HTTP gateway. The listener must not be reachable from outside.
+func WithInProcess(lis net.Listener) Option {
+	return func(o *options) {
+		o.inProcess = lis
+	}
+}
+
+// WithDeadlines caps the time every call may take, the time spent waiting
+// for the limits and the policy included.
+func WithDeadlines(deadlines *deadline.Enforcer) Option {
+	return func(o *options) {
+		o.deadlines = deadlines
+	}
+}
+
+// WithReadYourWrites lets calls ask to read from the primary with the
+// consistency.Header, rather than from a replica that may be behind.
+func WithReadYourWrites() Option {
+	return func(o *options) {
+		o.consistency = true
+	}
+}
+
+// WithReflection serves the reflection service, it needs no credentials.
+func WithReflection() Option {
+	return func(o *options) {
+		o.reflection = true
+	}
+}
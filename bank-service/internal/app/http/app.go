@@ -0,0 +1,63 @@
+package httpapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	_readHeaderTimeout = 5 * time.Second
+	_shutdownTimeout   = 5 * time.Second
+)
+
+// App serves the operational HTTP endpoints, like metrics, next to the gRPC server.
+type App struct {
+	log    *slog.Logger
+	server *http.Server
+	port   int
+}
+
+func New(log *slog.Logger, port int, handler http.Handler) *App {
+	return &App{
+		log: log,
+		server: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: _readHeaderTimeout,
+		},
+		port: port,
+	}
+}
+
+func (a *App) Run() error {
+	const op = "httpapp.App.Run"
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", a.port))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.log.Info("http server started", slog.Any("address", l.Addr().String()))
+
+	if err := a.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *App) Stop() {
+	const op = "httpapp.App.Stop"
+	a.log.Info(fmt.Sprintf("%s - stopping http server", op))
+
+	ctx, cancel := context.WithTimeout(context.Background(), _shutdownTimeout)
+	defer cancel()
+
+	if err := a.server.Shutdown(ctx); err != nil {
+		a.log.Error(fmt.Sprintf("%s - a.server.Shutdown: %v", op, err))
+	}
+}
@@ -0,0 +1,86 @@
+package jobsapp
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is run every Interval, a job without an interval is disabled.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type App struct {
+	log    *slog.Logger
+	jobs   []Job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(log *slog.Logger, jobs ...Job) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:    log,
+		jobs:   jobs,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Run schedules the jobs and blocks until the app is stopped.
+func (a *App) Run() error {
+	for _, job := range a.jobs {
+		if job.Interval <= 0 {
+			a.log.Info("job disabled", slog.String("job", job.Name))
+			continue
+		}
+
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			a.schedule(job)
+		}()
+	}
+
+	<-a.ctx.Done()
+	a.wg.Wait()
+
+	return nil
+}
+
+func (a *App) schedule(job Job) {
+	log := a.log.With(slog.String("job", job.Name))
+	log.Info("job scheduled", slog.Duration("interval", job.Interval))
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		if err := job.Run(a.ctx); err != nil {
+			log.Error("job failed", slog.Any("err", err))
+			continue
+		}
+		log.Info("job done", slog.Duration("took", time.Since(start)))
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (a *App) Stop() {
+	const op = "jobsapp.App.Stop"
+	a.log.Info(op + " - stopping jobs")
+	a.cancel()
+	a.wg.Wait()
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/authz"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/health"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/metrics"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/memdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/pgdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/auditlog"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/bank"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/reconciliation"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/snapshot"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/statement"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/transfer"
+	"github.com/d1mitrii/money-transfer/bank-service/migrations"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/tracing"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Storage backends.
+const (
+	_backendPostgres = "postgres"
+	_backendMemory   = "memory"
+)
+
+// bankRepository is implemented by the bank repositories of every backend.
+type bankRepository interface {
+	bank.AccountProvider
+	bank.BalanceProvider
+	statement.StatementProvider
+	snapshot.SnapshotProvider
+	authz.AccountOwners
+}
+
+// txManager runs the units of work of every backend.
+type txManager interface {
+	bank.TxManager
+	metrics.Committer
+}
+
+// storage holds the repositories of the configured backend.
+type storage struct {
+	bank           bankRepository
+	tx             txManager
+	transfers      transfer.TransferProvider
+	reconciliation reconciliation.ReconciliationProvider
+	audit          auditlog.AuditProvider
+	// db is pinged to report readiness.
+	db    health.Pinger
+	close func()
+}
+
+func newStorage(log *slog.Logger, cfg *config.Config, reg prometheus.Registerer) (*storage, error) {
+	switch cfg.Storage.Backend {
+	case _backendPostgres:
+		if cfg.Postgres.URL == "" {
+			return nil, errors.New("postgres url is not set")
+		}
+		isoLevel, err := postgres.ParseIsoLevel(cfg.Postgres.IsolationLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		pg, err := postgres.New(cfg.Postgres.URL,
+			postgres.MaxPoolSize(cfg.Postgres.MaxPoolSize),
+			postgres.StatementTimeout(cfg.Postgres.StatementTimeout),
+			postgres.Tracer(tracing.NewPgxTracer()),
+			postgres.Replicas(cfg.Postgres.ReplicaURLs...),
+			postgres.MaxReplicaLag(cfg.Postgres.MaxReplicaLag),
+			postgres.ReplicaCheckInterval(cfg.Postgres.ReplicaCheckInterval),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("postgres.New: %w", err)
+		}
+		if cfg.Postgres.AutoMigrate {
+			if err := migrate(log, pg); err != nil {
+				pg.Close()
+				return nil, fmt.Errorf("migrate: %w", err)
+			}
+		}
+
+		bankRepo := pgdb.New(pg)
+		if err := shardBalances(log, bankRepo, cfg.Postgres); err != nil {
+			pg.Close()
+			return nil, fmt.Errorf("shardBalances: %w", err)
+		}
+
+		reg.MustRegister(metrics.NewPoolCollector(pg.Pool))
+
+		// The batcher is closed before the pool, so the deposits it holds are written.
+		var repo bankRepository = bankRepo
+		closeStorage := pg.Close
+		if cfg.Postgres.DepositBatchWindow > 0 {
+			batcher := pgdb.NewDepositBatcher(bankRepo, cfg.Postgres.DepositBatchWindow, cfg.Postgres.DepositBatchSize)
+			repo = batcher
+			log.Info("deposits are batched",
+				slog.Duration("window", cfg.Postgres.DepositBatchWindow),
+				slog.Int("maxSize", cfg.Postgres.DepositBatchSize),
+			)
+			closeStorage = func() {
+				batcher.Close()
+				pg.Close()
+			}
+		}
+
+		txManager := postgres.NewTxManager(pg,
+			postgres.IsoLevel(isoLevel),
+			postgres.MaxRetries(cfg.Postgres.TxMaxRetries),
+		)
+
+		return &storage{
+			bank:           repo,
+			tx:             txManager,
+			transfers:      pgdb.NewTransferRepo(pg),
+			reconciliation: pgdb.NewReconciliationRepo(pg),
+			audit:          pgdb.NewAuditRepo(pg),
+			db:             pg.Pool,
+			close:          closeStorage,
+		}, nil
+	case _backendMemory:
+		log.Warn("storage is in memory, all data is lost on restart")
+
+		store := memdb.NewStore()
+
+		return &storage{
+			bank:           memdb.New(store),
+			tx:             store,
+			transfers:      memdb.NewTransferRepo(store),
+			reconciliation: memdb.NewReconciliationRepo(store),
+			audit:          memdb.NewAuditRepo(store),
+			db:             store,
+			close:          func() {},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+}
+
+// shardBalances shards the balances of the configured accounts and unshards
+// the ones configured as unsharded. Without either the schema is not touched,
+// so the service starts before the shards migration has been applied.
+func shardBalances(log *slog.Logger, repo *pgdb.BankRepo, cfg config.PostgresConfig) error {
+	sharded, err := parseAccounts(cfg.ShardedAccounts)
+	if err != nil {
+		return fmt.Errorf("sharded accounts: %w", err)
+	}
+	unsharded, err := parseAccounts(cfg.UnshardedAccounts)
+	if err != nil {
+		return fmt.Errorf("unsharded accounts: %w", err)
+	}
+	for _, accountUUID := range unsharded {
+		if slices.Contains(sharded, accountUUID) {
+			return fmt.Errorf("account %s is both sharded and unsharded", accountUUID)
+		}
+	}
+
+	if len(unsharded) > 0 {
+		if err := repo.ShardBalances(context.Background(), unsharded, 0); err != nil {
+			return err
+		}
+		log.Info("balances are unsharded", slog.Int("accounts", len(unsharded)))
+	}
+
+	if len(sharded) > 0 {
+		if cfg.BalanceShards < 1 {
+			return errors.New("balance shards must be positive")
+		}
+		if err := repo.ShardBalances(context.Background(), sharded, cfg.BalanceShards); err != nil {
+			return err
+		}
+		log.Info("balances are sharded",
+			slog.Int("accounts", len(sharded)),
+			slog.Int("shards", cfg.BalanceShards),
+		)
+	}
+
+	return nil
+}
+
+func parseAccounts(accounts []string) ([]uuid.UUID, error) {
+	uuids := make([]uuid.UUID, 0, len(accounts))
+	for _, account := range accounts {
+		accountUUID, err := uuid.Parse(account)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: %w", account, err)
+		}
+		uuids = append(uuids, accountUUID)
+	}
+	return uuids, nil
+}
+
+// migrate applies the pending migrations before the service starts using the schema.
+func migrate(log *slog.Logger, pg *postgres.Postgres) error {
+	migrator, err := postgres.NewMigrator(pg, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	results, err := migrator.Up(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		log.Info("migration applied",
+			slog.String("migration", result.Source.Path),
+			slog.Duration("duration", result.Duration),
+		)
+	}
+
+	return nil
+}
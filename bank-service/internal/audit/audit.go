@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Subject of the calls made while authentication is disabled.
+const _anonymous = "anonymous"
+
+// State-changing methods, every call of them is recorded.
+var audited = map[string]bool{
+	bankv1.Bank_CreateAccount_FullMethodName:     true,
+	bankv1.Bank_DeleteAccount_FullMethodName:     true,
+	bankv1.Bank_ImportAccounts_FullMethodName:    true,
+	bankv1.Bank_Deposit_FullMethodName:           true,
+	bankv1.Bank_Withdraw_FullMethodName:          true,
+	bankv1.Bank_Refund_FullMethodName:            true,
+	bankv1.Bank_BatchPostings_FullMethodName:     true,
+	bankv1.Bank_CreateTransfer_FullMethodName:    true,
+	bankv1.Bank_FailTransfer_FullMethodName:      true,
+	bankv1.Bank_ReconcileBalances_FullMethodName: true,
+}
+
+// errNotRecorded fails a call whose entry could not be recorded, the change
+// made by the call is rolled back along with the entry.
+var errNotRecorded = grpcerr.Internal(grpcerr.ReasonAuditEntryNotRecorded, "call could not be recorded, it has not been applied")
+
+type (
+	Recorder interface {
+		Record(ctx context.Context, entry models.AuditEntry) error
+	}
+
+	TxManager interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+// Auditor records who called a state-changing method, with which payload and result.
+// A unary call that succeeds is recorded in the same unit of work as the change
+// it makes, so neither is kept without the other. A client stream commits its
+// changes in several units of work, each of them is recorded along with its
+// change by the TxManager returned by WrapTxManager. Failed calls and finished
+// streams are recorded once they are done.
+type Auditor struct {
+	log       *slog.Logger
+	recorder  Recorder
+	txManager TxManager
+}
+
+func New(log *slog.Logger, recorder Recorder, txManager TxManager) *Auditor {
+	return &Auditor{
+		log:       log,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+// UnaryServerInterceptor runs the call in a unit of work and records it there
+// if it succeeds. The unit of work may be retried on a conflict with another
+// one, which runs the handler again. It goes after the interceptors rejecting
+// calls, so rejected calls take no unit of work and are not recorded.
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !audited[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		var (
+			resp       any
+			handlerErr error
+		)
+		err := a.txManager.Do(ctx, func(ctx context.Context) error {
+			resp, handlerErr = handler(ctx, req)
+			if handlerErr != nil {
+				return handlerErr
+			}
+			return a.record(ctx, info.FullMethod, marshal(req), resp, nil)
+		})
+		if handlerErr != nil {
+			a.recordFailure(ctx, info.FullMethod, marshal(req), handlerErr)
+			return nil, handlerErr
+		}
+		if err != nil {
+			a.logFailure(ctx, info.FullMethod, err)
+			a.recordFailure(ctx, info.FullMethod, marshal(req), errNotRecorded)
+			return nil, errNotRecorded
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor records the client streams once they are done, by
+// the number of received messages, the rows of an import are too many to be
+// kept in the log. The stream is not run in a unit of work of its own, the
+// units of work it commits are recorded as they are.
+func (a *Auditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !audited[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		stream := &auditedStream{ServerStream: ss, method: info.FullMethod}
+		stream.ctx = context.WithValue(ss.Context(), streamKey{}, stream)
+
+		err := handler(srv, stream)
+		// The units of work of the stream are committed already, the outcome
+		// of the stream is only logged if it cannot be recorded.
+		if err := a.record(context.WithoutCancel(ss.Context()), info.FullMethod, stream.request(), stream.sent, err); err != nil {
+			a.logFailure(ss.Context(), info.FullMethod, err)
+		}
+
+		return err
+	}
+}
+
+type (
+	streamKey struct{}
+	unitKey   struct{}
+)
+
+// WrapTxManager returns a TxManager recording every unit of work of an
+// audited stream in that unit of work, by the messages received since the
+// previous one. Units of work of other calls are run by m as they are.
+func (a *Auditor) WrapTxManager(m TxManager) TxManager {
+	return &txManager{TxManager: m, auditor: a}
+}
+
+type txManager struct {
+	TxManager
+	auditor *Auditor
+}
+
+func (m *txManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	stream, ok := ctx.Value(streamKey{}).(*auditedStream)
+	if !ok || ctx.Value(unitKey{}) != nil {
+		return m.TxManager.Do(ctx, fn)
+	}
+
+	var received int
+	err := m.TxManager.Do(ctx, func(ctx context.Context) error {
+		if err := fn(context.WithValue(ctx, unitKey{}, true)); err != nil {
+			return err
+		}
+		received = stream.received
+		request := fmt.Sprintf(`{"messages":%d}`, received-stream.recorded)
+		return m.auditor.record(ctx, stream.method, request, nil, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	stream.recorded = received
+	return nil
+}
+
+// recordFailure records a failed call. The call has failed already, so
+// a failure to record it is only logged. The entry is recorded even if the
+// caller has gone away in the meantime.
+func (a *Auditor) recordFailure(ctx context.Context, fullMethod, request string, err error) {
+	if err := a.record(context.WithoutCancel(ctx), fullMethod, request, nil, err); err != nil {
+		a.logFailure(ctx, fullMethod, err)
+	}
+}
+
+func (a *Auditor) record(ctx context.Context, fullMethod, request string, resp any, err error) error {
+	st := status.Convert(err)
+	entry := models.AuditEntry{
+		Subject: subject(ctx),
+		Method:  fullMethod,
+		Request: request,
+		Code:    st.Code().String(),
+		Error:   st.Message(),
+	}
+	if err == nil && resp != nil {
+		response := marshal(resp)
+		entry.Response = &response
+	}
+
+	return a.recorder.Record(ctx, entry)
+}
+
+func (a *Auditor) logFailure(ctx context.Context, fullMethod string, err error) {
+	const op = "Auditor.record"
+
+	a.log.ErrorContext(ctx, "failed to record audit entry",
+		slog.String("op", op),
+		slog.String("subject", subject(ctx)),
+		slog.String("method", fullMethod),
+		slog.Any("err", err),
+	)
+}
+
+func subject(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+	return _anonymous
+}
+
+func marshal(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return "null"
+	}
+
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return "null"
+	}
+
+	return string(b)
+}
+
+type auditedStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	method string
+	// received counts the messages of the stream, recorded the ones
+	// up to the last unit of work recorded.
+	received int
+	recorded int
+	sent     any
+}
+
+func (s *auditedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditedStream) request() string {
+	return fmt.Sprintf(`{"messages":%d}`, s.received)
+}
+
+func (s *auditedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received++
+	return nil
+}
+
+func (s *auditedStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.sent = m
+	return nil
+}
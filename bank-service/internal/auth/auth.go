@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	_authorizationHeader = "authorization"
+	_apiKeyHeader        = "x-api-key"
+)
+
+// Services that are reachable without credentials.
+var publicServices = []string{
+	"grpc.health.v1.Health",
+	"grpc.reflection.v1.ServerReflection",
+	"grpc.reflection.v1alpha.ServerReflection",
+}
+
+var (
+	errMissingCredentials = grpcerr.Unauthenticated(grpcerr.ReasonCredentialsMissing, "missing credentials")
+	errInvalidToken       = grpcerr.Unauthenticated(grpcerr.ReasonTokenInvalid, "invalid token")
+	errInvalidAPIKey      = grpcerr.Unauthenticated(grpcerr.ReasonAPIKeyInvalid, "invalid api key")
+)
+
+// Authenticator identifies callers by a JWT bearer token in the authorization
+// header, by a static key in x-api-key or by their client certificate.
+type Authenticator struct {
+	log        *slog.Logger
+	jwt        *jwtVerifier
+	apiKeys    map[[sha256.Size]byte]Identity
+	clientCert bool
+}
+
+func New(log *slog.Logger, cfg config.AuthConfig) (*Authenticator, error) {
+	const op = "auth.New"
+
+	verifier, err := newJWTVerifier(cfg.JWT)
+	if err != nil {
+		return nil, fmt.Errorf("%s - newJWTVerifier: %w", op, err)
+	}
+
+	apiKeys := make(map[[sha256.Size]byte]Identity, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		hash, err := hex.DecodeString(key.SHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("%s: api key %q: sha256 must be a hex encoded hash", op, key.Name)
+		}
+		apiKeys[[sha256.Size]byte(hash)] = Identity{
+			Subject: key.Name,
+			Roles:   key.Roles,
+			Method:  MethodAPIKey,
+		}
+	}
+
+	return &Authenticator{
+		log:        log,
+		jwt:        verifier,
+		apiKeys:    apiKeys,
+		clientCert: cfg.ClientCert,
+	}, nil
+}
+
+// Authenticate puts the identity of the caller into the context.
+// It is meant to be used as the auth.AuthFunc of the interceptors.
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	const op = "Authenticator.Authenticate"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if keys := md.Get(_apiKeyHeader); len(keys) > 0 {
+		identity, ok := a.apiKeys[sha256.Sum256([]byte(keys[0]))]
+		if !ok {
+			log.Warn("unknown api key")
+			return nil, errInvalidAPIKey
+		}
+		return WithIdentity(ctx, identity), nil
+	}
+
+	if values := md.Get(_authorizationHeader); len(values) > 0 {
+		scheme, token, ok := strings.Cut(values[0], " ")
+		if !ok || !strings.EqualFold(scheme, "bearer") {
+			return nil, errMissingCredentials
+		}
+
+		identity, err := a.jwt.verify(token)
+		if err != nil {
+			log.Warn("invalid token", slog.Any("err", err))
+			return nil, errInvalidToken
+		}
+		return WithIdentity(ctx, identity), nil
+	}
+
+	if a.clientCert {
+		if identity, ok := certIdentity(ctx); ok {
+			return WithIdentity(ctx, identity), nil
+		}
+	}
+
+	return nil, errMissingCredentials
+}
+
+// certIdentity takes the identity from the subject of a verified client
+// certificate: the common name is the subject and the organizational units are the roles.
+func certIdentity(ctx context.Context) (Identity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return Identity{}, false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return Identity{}, false
+	}
+
+	subject := info.State.VerifiedChains[0][0].Subject
+	if subject.CommonName == "" {
+		return Identity{}, false
+	}
+
+	return Identity{
+		Subject: subject.CommonName,
+		Roles:   subject.OrganizationalUnit,
+		Method:  MethodTLS,
+	}, true
+}
+
+// RequiresAuth tells whether the call needs credentials, it is meant to be used
+// as the selector.MatchFunc of the interceptors.
+func RequiresAuth(_ context.Context, c interceptors.CallMeta) bool {
+	for _, service := range publicServices {
+		if c.Service == service {
+			return false
+		}
+	}
+	return true
+}
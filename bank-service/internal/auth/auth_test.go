@@ -0,0 +1,303 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	_secret   = "test-secret"
+	_issuer   = "bank-tests"
+	_audience = "bank-service"
+)
+
+// keys are the signing keys of the tests, the public halves of rsaKey and
+// edKey are published in a JWKS file, otherKey is known to nobody.
+type keys struct {
+	rsaKey   *rsa.PrivateKey
+	edKey    ed25519.PrivateKey
+	otherKey ed25519.PrivateKey
+	jwksFile string
+}
+
+func newKeys(t *testing.T) keys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey: %v", err)
+	}
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey: %v", err)
+	}
+
+	b64 := base64.RawURLEncoding.EncodeToString
+	set := map[string][]map[string]string{"keys": {
+		{
+			"kty": "RSA",
+			"kid": "rsa-1",
+			"use": "sig",
+			"n":   b64(rsaKey.N.Bytes()),
+			"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+		// Without a kid the key is tried for every token.
+		{"kty": "OKP", "crv": "Ed25519", "x": b64(edPub)},
+		// Encryption keys are skipped.
+		{"kty": "OKP", "crv": "Ed25519", "use": "enc", "x": b64(otherKey.Public().(ed25519.PublicKey))},
+	}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, data, 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+
+	return keys{rsaKey: rsaKey, edKey: edKey, otherKey: otherKey, jwksFile: jwksFile}
+}
+
+func newAuthenticator(t *testing.T, cfg config.AuthConfig) *auth.Authenticator {
+	t.Helper()
+
+	a, err := auth.New(slog.New(slog.NewTextHandler(io.Discard, nil)), cfg)
+	if err != nil {
+		t.Fatalf("auth.New: %v", err)
+	}
+	return a
+}
+
+// claims are valid for an hour unless changed by the test.
+func claims(change func(c jwt.MapClaims)) jwt.MapClaims {
+	now := time.Now()
+	c := jwt.MapClaims{
+		"sub":   "alice",
+		"iss":   _issuer,
+		"aud":   _audience,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"roles": []string{"customer"},
+	}
+	if change != nil {
+		change(c)
+	}
+	return c
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, c jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, c)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return signed
+}
+
+func withHeader(key, value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, value))
+}
+
+func TestAuthenticateJWT(t *testing.T) {
+	k := newKeys(t)
+	a := newAuthenticator(t, config.AuthConfig{JWT: config.JWTConfig{
+		Issuer:     _issuer,
+		Audience:   _audience,
+		HMACSecret: _secret,
+		JWKSFile:   k.jwksFile,
+	}})
+
+	hmac := jwt.SigningMethodHS256
+	tests := []struct {
+		name  string
+		token string
+		// reason is the ErrorInfo reason of the rejection, empty if the token is accepted.
+		reason string
+	}{
+		{
+			name:  "hmac",
+			token: sign(t, hmac, []byte(_secret), "", claims(nil)),
+		},
+		{
+			name:  "rsa key of the jwks by kid",
+			token: sign(t, jwt.SigningMethodRS256, k.rsaKey, "rsa-1", claims(nil)),
+		},
+		{
+			name:  "ed25519 key of the jwks without kid",
+			token: sign(t, jwt.SigningMethodEdDSA, k.edKey, "", claims(nil)),
+		},
+		{
+			name:  "expired within the leeway",
+			token: sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-10 * time.Second).Unix() })),
+		},
+		{
+			name:   "expired",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "without expiry",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { delete(c, "exp") })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "not valid yet",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { c["nbf"] = time.Now().Add(time.Hour).Unix() })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "other issuer",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { c["iss"] = "someone" })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "other audience",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { c["aud"] = "someone" })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "without subject",
+			token:  sign(t, hmac, []byte(_secret), "", claims(func(c jwt.MapClaims) { delete(c, "sub") })),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "wrong secret",
+			token:  sign(t, hmac, []byte("other-secret"), "", claims(nil)),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "unknown key",
+			token:  sign(t, jwt.SigningMethodEdDSA, k.otherKey, "", claims(nil)),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "unknown kid",
+			token:  sign(t, jwt.SigningMethodEdDSA, k.otherKey, "rsa-2", claims(nil)),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "unsigned",
+			token:  sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", claims(nil)),
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+		{
+			name:   "malformed",
+			token:  "not.a.token",
+			reason: grpcerr.ReasonTokenInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := a.Authenticate(withHeader("authorization", "Bearer "+tt.token))
+			if tt.reason != "" {
+				if got := grpcerr.Reason(err); got != tt.reason {
+					t.Fatalf("reason: got %q (%v), want %q", got, err, tt.reason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+
+			identity, ok := auth.FromContext(ctx)
+			if !ok {
+				t.Fatal("no identity in the context")
+			}
+			if identity.Subject != "alice" || !identity.HasRole("customer") || identity.Method != auth.MethodJWT {
+				t.Errorf("identity: got %+v", identity)
+			}
+		})
+	}
+}
+
+func TestAuthenticateJWTWithoutSecret(t *testing.T) {
+	k := newKeys(t)
+	a := newAuthenticator(t, config.AuthConfig{JWT: config.JWTConfig{JWKSFile: k.jwksFile}})
+
+	// An HMAC token can not be verified with a public key taken as the secret.
+	token := sign(t, jwt.SigningMethodHS256, k.rsaKey.PublicKey.N.Bytes(), "rsa-1", claims(nil))
+	_, err := a.Authenticate(withHeader("authorization", "Bearer "+token))
+	if got := grpcerr.Reason(err); got != grpcerr.ReasonTokenInvalid {
+		t.Errorf("reason: got %q (%v), want %q", got, err, grpcerr.ReasonTokenInvalid)
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	hash := sha256.Sum256([]byte("worker-key"))
+	a := newAuthenticator(t, config.AuthConfig{
+		JWT: config.JWTConfig{HMACSecret: _secret},
+		APIKeys: []config.APIKeyConfig{
+			{Name: "worker", SHA256: hex.EncodeToString(hash[:]), Roles: []string{"operator"}},
+		},
+	})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		reason string
+	}{
+		{name: "known key", ctx: withHeader("x-api-key", "worker-key")},
+		{name: "unknown key", ctx: withHeader("x-api-key", "other-key"), reason: grpcerr.ReasonAPIKeyInvalid},
+		{name: "basic scheme", ctx: withHeader("authorization", "Basic d29ya2VyOmtleQ=="), reason: grpcerr.ReasonCredentialsMissing},
+		{name: "no credentials", ctx: context.Background(), reason: grpcerr.ReasonCredentialsMissing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := a.Authenticate(tt.ctx)
+			if tt.reason != "" {
+				if got := grpcerr.Reason(err); got != tt.reason {
+					t.Fatalf("reason: got %q (%v), want %q", got, err, tt.reason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+
+			identity, _ := auth.FromContext(ctx)
+			if identity.Subject != "worker" || !identity.HasRole("operator") || identity.Method != auth.MethodAPIKey {
+				t.Errorf("identity: got %+v", identity)
+			}
+		})
+	}
+}
+
+func TestNewRejectsMalformedAPIKeyHash(t *testing.T) {
+	_, err := auth.New(slog.New(slog.NewTextHandler(io.Discard, nil)), config.AuthConfig{
+		APIKeys: []config.APIKeyConfig{{Name: "worker", SHA256: "not-a-hash"}},
+	})
+	if err == nil {
+		t.Error("New: got nil error for a malformed hash")
+	}
+}
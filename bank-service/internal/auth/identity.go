@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	MethodJWT    = "jwt"
+	MethodAPIKey = "api_key"
+	MethodTLS    = "tls"
+)
+
+// Identity is the authenticated caller of an RPC.
+type Identity struct {
+	Subject string
+	Roles   []string
+	// Method tells how the caller has been authenticated.
+	Method string
+}
+
+func (i Identity) HasRole(role string) bool {
+	return slices.Contains(i.Roles, role)
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity of the caller, if the call has been authenticated.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// The JWKS file is checked for rotated keys at most once per interval.
+const _jwksReloadInterval = time.Minute
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// jwtVerifier checks token signatures against an HMAC secret,
+// PEM encoded public keys and the keys of a JWKS file.
+type jwtVerifier struct {
+	parser   *jwt.Parser
+	secret   []byte
+	pemKeys  []jwt.VerificationKey
+	jwksFile string
+
+	mu        sync.RWMutex
+	jwksKeys  map[string]jwt.VerificationKey
+	jwksMod   time.Time
+	jwksCheck time.Time
+}
+
+func newJWTVerifier(cfg config.JWTConfig) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		jwksFile: cfg.JWKSFile,
+	}
+
+	if cfg.HMACSecret != "" {
+		v.secret = []byte(cfg.HMACSecret)
+	}
+
+	for _, path := range cfg.PublicKeyFiles {
+		key, err := readPEMKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+		v.pemKeys = append(v.pemKeys, key)
+	}
+
+	if v.jwksFile != "" {
+		if err := v.reloadJWKS(); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// methods lists the algorithms of the configured keys,
+// tokens signed with any other algorithm are rejected.
+func (v *jwtVerifier) methods() []string {
+	var methods []string
+	if v.secret != nil {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if v.pemKeys != nil || v.jwksFile != "" {
+		methods = append(methods,
+			"RS256", "RS384", "RS512",
+			"PS256", "PS384", "PS512",
+			"ES256", "ES384", "ES512",
+			"EdDSA",
+		)
+	}
+	return methods
+}
+
+func (v *jwtVerifier) verify(token string) (Identity, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.keyFunc); err != nil {
+		return Identity{}, err
+	}
+
+	if c.Subject == "" {
+		return Identity{}, errors.New("token has no subject")
+	}
+
+	return Identity{
+		Subject: c.Subject,
+		Roles:   c.Roles,
+		Method:  MethodJWT,
+	}, nil
+}
+
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (any, error) {
+	// The secret is never handed out for asymmetric algorithms and the other
+	// way around, so a public key can not be used as an HMAC secret.
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if v.secret == nil {
+			return nil, errors.New("hmac tokens are not accepted")
+		}
+		return v.secret, nil
+	}
+
+	if v.jwksFile != "" && time.Since(v.lastJWKSCheck()) > _jwksReloadInterval {
+		// A broken file keeps the keys loaded before.
+		_ = v.reloadJWKS()
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if kid, ok := token.Header["kid"].(string); ok {
+		if key, ok := v.jwksKeys[kid]; ok {
+			return key, nil
+		}
+	}
+
+	keys := jwt.VerificationKeySet{Keys: append([]jwt.VerificationKey{}, v.pemKeys...)}
+	for _, key := range v.jwksKeys {
+		keys.Keys = append(keys.Keys, key)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("no verification keys")
+	}
+
+	return keys, nil
+}
+
+func (v *jwtVerifier) lastJWKSCheck() time.Time {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.jwksCheck
+}
+
+func (v *jwtVerifier) reloadJWKS() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.jwksCheck = time.Now()
+
+	info, err := os.Stat(v.jwksFile)
+	if err != nil {
+		return fmt.Errorf("stat jwks: %w", err)
+	}
+	if info.ModTime().Equal(v.jwksMod) {
+		return nil
+	}
+
+	data, err := os.ReadFile(v.jwksFile)
+	if err != nil {
+		return fmt.Errorf("read jwks: %w", err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("parse jwks: %w", err)
+	}
+
+	v.jwksKeys, v.jwksMod = keys, info.ModTime()
+
+	return nil
+}
+
+func readPEMKey(path string) (jwt.VerificationKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data")
+	}
+
+	var key any
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %T", key)
+}
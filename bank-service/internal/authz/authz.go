@@ -0,0 +1,218 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Request fields naming the accounts a call acts on. Callers limited to their
+// own accounts must own every account given in them. The target of a transfer
+// is not among them, as money can be sent to anyone.
+var ownedFields = []protoreflect.Name{"AccountUUID", "FromAccountUUID"}
+
+// Accounts created by callers limited to their own accounts are owned by them
+// and open with no balance, their money only comes from transfers.
+const (
+	ownerField   protoreflect.Name = "Owner"
+	balanceField protoreflect.Name = "Balance"
+)
+
+var (
+	errUnauthenticated = grpcerr.Unauthenticated(grpcerr.ReasonUnauthenticated, "unauthenticated")
+	errMethodDenied    = grpcerr.PermissionDenied(grpcerr.ReasonMethodDenied, "method is not allowed")
+	errAccountDenied   = grpcerr.PermissionDenied(grpcerr.ReasonAccountDenied, "account is not owned by caller")
+	errNoAccount       = grpcerr.PermissionDenied(grpcerr.ReasonAccountMissing, "request must name an owned account")
+	errBalanceDenied   = grpcerr.PermissionDenied(grpcerr.ReasonOpeningBalanceDenied, "account must be opened with no balance")
+)
+
+type AccountOwners interface {
+	AccountOwner(ctx context.Context, accountUUID uuid.UUID) (string, error)
+}
+
+// Authorizer enforces a Policy on authenticated callers.
+type Authorizer struct {
+	log    *slog.Logger
+	roles  map[string]role
+	owners AccountOwners
+}
+
+func New(log *slog.Logger, policy Policy, owners AccountOwners) (*Authorizer, error) {
+	const op = "authz.New"
+
+	roles := make(map[string]role, len(policy.Roles))
+	for name, rp := range policy.Roles {
+		r, err := compileRole(rp)
+		if err != nil {
+			return nil, fmt.Errorf("%s: role %q: %w", op, name, err)
+		}
+		roles[name] = r
+	}
+
+	return &Authorizer{
+		log:    log,
+		roles:  roles,
+		owners: owners,
+	}, nil
+}
+
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		identity, restricted, err := a.authorizeMethod(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		if restricted {
+			if err := a.authorizeRequest(ctx, identity, req); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		identity, restricted, err := a.authorizeMethod(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		if restricted {
+			ss = &authorizedStream{ServerStream: ss, authorizer: a, identity: identity}
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// authorizedStream checks every received message of a restricted caller.
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer *Authorizer
+	identity   auth.Identity
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorizer.authorizeRequest(s.Context(), s.identity, m)
+}
+
+// authorizeMethod checks whether any role of the caller allows the method.
+// It reports whether the call is limited to the accounts of the caller,
+// which is the case unless one of the roles allows the method without limits.
+func (a *Authorizer) authorizeMethod(ctx context.Context, fullMethod string) (auth.Identity, bool, error) {
+	const op = "Authorizer.authorizeMethod"
+
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.Identity{}, false, errUnauthenticated
+	}
+
+	allowed, restricted := false, true
+	for _, name := range identity.Roles {
+		r, ok := a.roles[name]
+		if !ok || !r.allows(fullMethod) {
+			continue
+		}
+		allowed = true
+		if !r.ownAccounts {
+			restricted = false
+			break
+		}
+	}
+
+	if !allowed {
+		a.log.Warn("method denied",
+			slog.String("op", op),
+			slog.String("subject", identity.Subject),
+			slog.String("method", fullMethod),
+		)
+		return auth.Identity{}, false, errMethodDenied
+	}
+
+	return identity, restricted, nil
+}
+
+// authorizeRequest checks that the accounts named in the request are owned by
+// the caller and makes the caller the owner of the accounts it creates, which
+// must open with no balance. The rows of an import are checked the same way.
+func (a *Authorizer) authorizeRequest(ctx context.Context, identity auth.Identity, req any) error {
+	const op = "Authorizer.authorizeRequest"
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("subject", identity.Subject),
+	)
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return errNoAccount
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	if fd := fields.ByName(ownerField); fd != nil && fd.Kind() == protoreflect.StringKind {
+		if bfd := fields.ByName(balanceField); bfd != nil && bfd.Kind() == protoreflect.Int64Kind && m.Has(bfd) {
+			log.Warn("opening balance denied", slog.Int64("balance", m.Get(bfd).Int()))
+			return errBalanceDenied
+		}
+
+		switch owner := m.Get(fd).String(); owner {
+		case "":
+			m.Set(fd, protoreflect.ValueOfString(identity.Subject))
+			return nil
+		case identity.Subject:
+			return nil
+		default:
+			log.Warn("foreign owner", slog.String("owner", owner))
+			return errAccountDenied
+		}
+	}
+
+	named := false
+	for _, name := range ownedFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || m.Get(fd).String() == "" {
+			continue
+		}
+		named = true
+
+		accountUUID, err := uuid.Parse(m.Get(fd).String())
+		if err != nil {
+			// Left to the handler to report.
+			continue
+		}
+
+		owner, err := a.owners.AccountOwner(ctx, accountUUID)
+		if err != nil && !errors.Is(err, repoerr.ErrNotFound) {
+			log.Error("failed to get account owner", slog.Any("err", err))
+			return grpcerr.ErrInternal
+		}
+
+		// Unknown accounts are denied the same way as foreign ones,
+		// so callers can not probe which accounts exist.
+		if err != nil || owner == "" || owner != identity.Subject {
+			log.Warn("account denied", slog.String("accountUUID", accountUUID.String()))
+			return errAccountDenied
+		}
+	}
+
+	if !named {
+		return errNoAccount
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/authz"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// owners maps accounts to their owners, an account missing from it is not found.
+type owners map[uuid.UUID]string
+
+func (o owners) AccountOwner(_ context.Context, accountUUID uuid.UUID) (string, error) {
+	if accountUUID == brokenAccount {
+		return "", errors.New("connection lost")
+	}
+	owner, ok := o[accountUUID]
+	if !ok {
+		return "", repoerr.ErrNotFound
+	}
+	return owner, nil
+}
+
+var (
+	aliceAccount  = uuid.New()
+	bobAccount    = uuid.New()
+	bankAccount   = uuid.New()
+	brokenAccount = uuid.New()
+)
+
+func newAuthorizer(t *testing.T) *authz.Authorizer {
+	t.Helper()
+
+	policy := authz.Policy{Roles: map[string]authz.RolePolicy{
+		authz.RoleAdmin:  {Methods: []string{"*"}},
+		authz.RoleTeller: {Methods: []string{"CreateAccount", "GetAccount", "Deposit"}},
+		authz.RoleCustomer: {
+			Methods:     []string{"CreateAccount", "GetAccount", "CreateTransfer", "ImportAccounts"},
+			OwnAccounts: true,
+		},
+	}}
+
+	a, err := authz.New(slog.New(slog.NewTextHandler(io.Discard, nil)), policy, owners{
+		aliceAccount: "alice",
+		bobAccount:   "bob",
+		bankAccount:  "",
+	})
+	if err != nil {
+		t.Fatalf("authz.New: %v", err)
+	}
+	return a
+}
+
+func method(name string) string {
+	return "/" + bankv1.Bank_ServiceDesc.ServiceName + "/" + name
+}
+
+func TestNewRejectsUnknownMethod(t *testing.T) {
+	_, err := authz.New(slog.New(slog.NewTextHandler(io.Discard, nil)), authz.Policy{Roles: map[string]authz.RolePolicy{
+		authz.RoleTeller: {Methods: []string{"Steal"}},
+	}}, owners{})
+	if err == nil {
+		t.Error("New: got nil error for an unknown method")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a := newAuthorizer(t)
+	interceptor := a.UnaryServerInterceptor()
+
+	alice := auth.Identity{Subject: "alice", Roles: []string{authz.RoleCustomer}}
+	tests := []struct {
+		name     string
+		identity *auth.Identity
+		method   string
+		req      proto.Message
+		// reason is the ErrorInfo reason of the rejection, empty if the call is allowed.
+		reason string
+		code   codes.Code
+	}{
+		{
+			name:   "unauthenticated",
+			method: method("GetAccount"),
+			req:    &bankv1.GetAccountRequest{AccountUUID: aliceAccount.String()},
+			reason: grpcerr.ReasonUnauthenticated,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:     "admin may call anything",
+			identity: &auth.Identity{Subject: "root", Roles: []string{authz.RoleAdmin}},
+			method:   method("DeleteAccount"),
+			req:      &bankv1.DeleteAccountRequest{AccountUUID: bobAccount.String()},
+		},
+		{
+			name:     "teller acts on any account",
+			identity: &auth.Identity{Subject: "tom", Roles: []string{authz.RoleTeller}},
+			method:   method("Deposit"),
+			req:      &bankv1.DepositRequest{AccountUUID: bobAccount.String(), Amount: 10},
+		},
+		{
+			name:     "method of no role",
+			identity: &auth.Identity{Subject: "tom", Roles: []string{authz.RoleTeller}},
+			method:   method("DeleteAccount"),
+			req:      &bankv1.DeleteAccountRequest{AccountUUID: bobAccount.String()},
+			reason:   grpcerr.ReasonMethodDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "unknown role",
+			identity: &auth.Identity{Subject: "eve", Roles: []string{"intruder"}},
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: aliceAccount.String()},
+			reason:   grpcerr.ReasonMethodDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "unrestricted role wins",
+			identity: &auth.Identity{Subject: "alice", Roles: []string{authz.RoleCustomer, authz.RoleTeller}},
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: bobAccount.String()},
+		},
+		{
+			name:     "own account",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: aliceAccount.String()},
+		},
+		{
+			name:     "foreign account",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: bobAccount.String()},
+			reason:   grpcerr.ReasonAccountDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "account without owner",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: bankAccount.String()},
+			reason:   grpcerr.ReasonAccountDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "unknown account is denied like a foreign one",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: uuid.NewString()},
+			reason:   grpcerr.ReasonAccountDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "owner lookup failed",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{AccountUUID: brokenAccount.String()},
+			code:     codes.Internal,
+		},
+		{
+			name:     "no account named",
+			identity: &alice,
+			method:   method("GetAccount"),
+			req:      &bankv1.GetAccountRequest{},
+			reason:   grpcerr.ReasonAccountMissing,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "transfer from own account to anyone",
+			identity: &alice,
+			method:   method("CreateTransfer"),
+			req: &bankv1.CreateTransferRequest{
+				FromAccountUUID: aliceAccount.String(),
+				ToAccountUUID:   bobAccount.String(),
+				Amount:          10,
+			},
+		},
+		{
+			name:     "transfer from foreign account",
+			identity: &alice,
+			method:   method("CreateTransfer"),
+			req: &bankv1.CreateTransferRequest{
+				FromAccountUUID: bobAccount.String(),
+				ToAccountUUID:   aliceAccount.String(),
+				Amount:          10,
+			},
+			reason: grpcerr.ReasonAccountDenied,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:     "create own account",
+			identity: &alice,
+			method:   method("CreateAccount"),
+			req:      &bankv1.CreateAccountRequest{Name: "savings", Owner: "alice"},
+		},
+		{
+			name:     "create account for someone else",
+			identity: &alice,
+			method:   method("CreateAccount"),
+			req:      &bankv1.CreateAccountRequest{Name: "savings", Owner: "bob"},
+			reason:   grpcerr.ReasonAccountDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "create account with opening balance",
+			identity: &alice,
+			method:   method("CreateAccount"),
+			req:      &bankv1.CreateAccountRequest{Name: "savings", Balance: 1_000_000},
+			reason:   grpcerr.ReasonOpeningBalanceDenied,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "teller opens account with balance",
+			identity: &auth.Identity{Subject: "tom", Roles: []string{authz.RoleTeller}},
+			method:   method("CreateAccount"),
+			req:      &bankv1.CreateAccountRequest{Name: "savings", Balance: 100, Owner: "bob"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = auth.WithIdentity(ctx, *tt.identity)
+			}
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Fatalf("got %v, want the call allowed", err)
+				}
+				if !called {
+					t.Error("handler not called")
+				}
+				return
+			}
+
+			if called {
+				t.Error("handler called for a denied call")
+			}
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("code: got %v (%v), want %v", got, err, tt.code)
+			}
+			if got := grpcerr.Reason(err); tt.reason != "" && got != tt.reason {
+				t.Errorf("reason: got %q, want %q", got, tt.reason)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorSetsOwner(t *testing.T) {
+	interceptor := newAuthorizer(t).UnaryServerInterceptor()
+	ctx := auth.WithIdentity(context.Background(), auth.Identity{Subject: "alice", Roles: []string{authz.RoleCustomer}})
+
+	req := &bankv1.CreateAccountRequest{Name: "savings"}
+	_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method("CreateAccount")},
+		func(ctx context.Context, req any) (any, error) { return nil, nil })
+	if err != nil {
+		t.Fatalf("got %v, want the call allowed", err)
+	}
+	if req.GetOwner() != "alice" {
+		t.Errorf("owner: got %q, want %q", req.GetOwner(), "alice")
+	}
+}
+
+// rowStream is a server stream receiving the import rows.
+type rowStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	rows []*bankv1.ImportAccountRow
+}
+
+func (s *rowStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *rowStream) RecvMsg(m any) error {
+	if len(s.rows) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.rows[0])
+	s.rows = s.rows[1:]
+	return nil
+}
+
+func TestStreamServerInterceptorChecksImportRows(t *testing.T) {
+	interceptor := newAuthorizer(t).StreamServerInterceptor()
+	ctx := auth.WithIdentity(context.Background(), auth.Identity{Subject: "alice", Roles: []string{authz.RoleCustomer}})
+
+	ss := &rowStream{ctx: ctx, rows: []*bankv1.ImportAccountRow{
+		{ExternalID: "1", Name: "savings"},
+		{ExternalID: "2", Name: "checking", Balance: 500},
+	}}
+
+	var received []*bankv1.ImportAccountRow
+	handler := func(srv any, ss grpc.ServerStream) error {
+		for {
+			row := &bankv1.ImportAccountRow{}
+			if err := ss.RecvMsg(row); err != nil {
+				return err
+			}
+			received = append(received, row)
+		}
+	}
+
+	err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: method("ImportAccounts")}, handler)
+	if got := grpcerr.Reason(err); got != grpcerr.ReasonOpeningBalanceDenied {
+		t.Errorf("reason: got %q (%v), want %q", got, err, grpcerr.ReasonOpeningBalanceDenied)
+	}
+	if len(received) != 1 || received[0].GetOwner() != "alice" {
+		t.Errorf("received: got %v, want the first row owned by alice", received)
+	}
+}
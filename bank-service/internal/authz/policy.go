@@ -0,0 +1,97 @@
+package authz
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RoleAdmin    = "admin"
+	RoleTeller   = "teller"
+	RoleCustomer = "customer"
+	RoleService  = "service"
+	RoleAuditor  = "auditor"
+)
+
+// Policy lists the methods every role may call. Methods are either
+// names of Bank RPCs or full method names, "*" stands for every method.
+//
+//	roles:
+//	  customer:
+//	    methods: [GetAccount, CreateTransfer]
+//	    own_accounts: true
+type Policy struct {
+	Roles map[string]RolePolicy `yaml:"roles"`
+}
+
+type RolePolicy struct {
+	Methods []string `yaml:"methods"`
+	// OwnAccounts limits the role to the accounts owned by the caller.
+	OwnAccounts bool `yaml:"own_accounts"`
+}
+
+func LoadPolicy(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	var policy Policy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return Policy{}, err
+	}
+
+	return policy, nil
+}
+
+type role struct {
+	all         bool
+	methods     map[string]bool
+	ownAccounts bool
+}
+
+func compileRole(policy RolePolicy) (role, error) {
+	r := role{
+		methods:     make(map[string]bool, len(policy.Methods)),
+		ownAccounts: policy.OwnAccounts,
+	}
+
+	for _, method := range policy.Methods {
+		if method == "*" {
+			r.all = true
+			continue
+		}
+
+		if !strings.HasPrefix(method, "/") {
+			if !isBankMethod(method) {
+				return role{}, fmt.Errorf("unknown method %q", method)
+			}
+			method = "/" + bankv1.Bank_ServiceDesc.ServiceName + "/" + method
+		}
+		r.methods[method] = true
+	}
+
+	return r, nil
+}
+
+func (r role) allows(fullMethod string) bool {
+	return r.all || r.methods[fullMethod]
+}
+
+func isBankMethod(name string) bool {
+	for _, m := range bankv1.Bank_ServiceDesc.Methods {
+		if m.MethodName == name {
+			return true
+		}
+	}
+	for _, s := range bankv1.Bank_ServiceDesc.Streams {
+		if s.StreamName == name {
+			return true
+		}
+	}
+	return false
+}
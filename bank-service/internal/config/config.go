@@ -10,18 +10,187 @@ import (
 
 type (
 	Config struct {
-		GRPC     GRPCConfig     `yaml:"grpc"`
-		Postgres PostgresConfig `yaml:"postgres"`
+		GRPC           GRPCConfig           `yaml:"grpc"`
+		Storage        StorageConfig        `yaml:"storage"`
+		Postgres       PostgresConfig       `yaml:"postgres"`
+		Reconciliation ReconciliationConfig `yaml:"reconciliation"`
+		Snapshots      SnapshotsConfig      `yaml:"snapshots"`
+		Auth           AuthConfig           `yaml:"auth"`
+		Authz          AuthzConfig          `yaml:"authz"`
+		RateLimit      RateLimitConfig      `yaml:"rate_limit"`
+		HTTP           HTTPConfig           `yaml:"http"`
+		Gateway        GatewayConfig        `yaml:"gateway"`
+		Tracing        TracingConfig        `yaml:"tracing"`
+		Health         HealthConfig         `yaml:"health"`
+		Audit          AuditConfig          `yaml:"audit"`
 	}
 
 	GRPCConfig struct {
 		Port    int           `env-required:"true" yaml:"port" env:"GRPC_PORT"`
 		Timeout time.Duration `env-required:"true" yaml:"timeout" env:"GRPC_TIMEOUT"`
+		// MethodTimeouts override Timeout for the Bank methods by name, zero lifts the cap.
+		MethodTimeouts map[string]time.Duration `yaml:"method_timeouts"`
+		TLS            TLSConfig                `yaml:"tls"`
+		// Reflection lets tools like grpcurl discover the services without the protos.
+		Reflection bool `yaml:"reflection" env:"GRPC_REFLECTION"`
 	}
 
+	// HTTPConfig is the server of the operational endpoints, like /metrics.
+	HTTPConfig struct {
+		Port int `env-default:"8080" yaml:"port" env:"HTTP_PORT"`
+	}
+
+	// GatewayConfig serves the Bank RPCs as HTTP/JSON, over TLS with the
+	// certificates of the gRPC server. Without them it serves plain HTTP,
+	// which is refused while authentication is enabled.
+	GatewayConfig struct {
+		Enabled bool `yaml:"enabled" env:"GATEWAY_ENABLED"`
+		Port    int  `env-default:"8081" yaml:"port" env:"GATEWAY_PORT"`
+	}
+
+	// HealthConfig sets how often the database is pinged to report readiness.
+	// On shutdown the service reports not serving for ShutdownDelay before it
+	// stops taking calls, so load balancers have time to notice.
+	HealthConfig struct {
+		CheckInterval time.Duration `env-default:"5s" yaml:"check_interval" env:"HEALTH_CHECK_INTERVAL"`
+		CheckTimeout  time.Duration `env-default:"2s" yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+		ShutdownDelay time.Duration `env-default:"5s" yaml:"shutdown_delay" env:"HEALTH_SHUTDOWN_DELAY"`
+	}
+
+	// TracingConfig exports spans over OTLP/gRPC or prints them to stdout or File.
+	// Without an exporter trace ids are still propagated and added to the logs.
+	TracingConfig struct {
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+		Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+		Insecure    bool    `yaml:"insecure" env:"TRACING_INSECURE"`
+		File        string  `yaml:"file" env:"TRACING_FILE"`
+		SampleRatio float64 `env-default:"1" yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+	}
+
+	// TLSConfig enables TLS if a certificate is set. The files are reloaded when
+	// they change. Client certificates are verified against ClientCAFile.
+	TLSConfig struct {
+		CertFile          string        `yaml:"cert_file" env:"GRPC_TLS_CERT_FILE"`
+		KeyFile           string        `yaml:"key_file" env:"GRPC_TLS_KEY_FILE"`
+		ClientCAFile      string        `yaml:"client_ca_file" env:"GRPC_TLS_CLIENT_CA_FILE"`
+		RequireClientCert bool          `yaml:"require_client_cert" env:"GRPC_TLS_REQUIRE_CLIENT_CERT"`
+		ReloadInterval    time.Duration `env-default:"1m" yaml:"reload_interval" env:"GRPC_TLS_RELOAD_INTERVAL"`
+	}
+
+	// StorageConfig selects where the data is kept: "postgres" or "memory".
+	// The memory backend loses everything on restart, it is meant for local
+	// runs and tests without a database.
+	StorageConfig struct {
+		Backend string `env-default:"postgres" yaml:"backend" env:"STORAGE_BACKEND"`
+	}
+
+	// PostgresConfig bounds every statement by StatementTimeout, zero means no bound.
+	// Statements of calls are also cancelled once the deadline of the call passes.
+	// Units of work run at IsolationLevel: read_committed, repeatable_read or
+	// serializable, and are retried up to TxMaxRetries times on serialization
+	// failures and deadlocks. With AutoMigrate the pending migrations are applied
+	// on startup, replicas starting at once apply them one after another.
 	PostgresConfig struct {
-		URL         string `env-required:"true" yaml:"url" env:"PG_URL"`
-		MaxPoolSize int    `yaml:"max_pool_size" env:"PG_MAX_POOL_SIZE"`
+		// URL is required by the postgres backend only.
+		URL              string        `yaml:"url" env:"PG_URL"`
+		MaxPoolSize      int           `yaml:"max_pool_size" env:"PG_MAX_POOL_SIZE"`
+		StatementTimeout time.Duration `yaml:"statement_timeout" env:"PG_STATEMENT_TIMEOUT"`
+		IsolationLevel   string        `env-default:"read_committed" yaml:"isolation_level" env:"PG_ISOLATION_LEVEL"`
+		TxMaxRetries     int           `env-default:"3" yaml:"tx_max_retries" env:"PG_TX_MAX_RETRIES"`
+		AutoMigrate      bool          `yaml:"auto_migrate" env:"PG_AUTO_MIGRATE"`
+		// Replicas serve the reads of accounts, transfers, statements and the
+		// audit log while they are behind the primary by at most MaxReplicaLag.
+		// A replica not streaming from the primary is not read from, its user
+		// needs pg_read_all_stats for the replication state to be seen.
+		ReplicaURLs          []string      `yaml:"replica_urls" env:"PG_REPLICA_URLS"`
+		MaxReplicaLag        time.Duration `env-default:"1s" yaml:"max_replica_lag" env:"PG_MAX_REPLICA_LAG"`
+		ReplicaCheckInterval time.Duration `env-default:"1s" yaml:"replica_check_interval" env:"PG_REPLICA_CHECK_INTERVAL"`
+		// Accounts credited by many calls at once, like settlement accounts,
+		// spread their credits over BalanceShards rows rather than their own.
+		// Accounts stay sharded until they are given in UnshardedAccounts.
+		ShardedAccounts   []string `yaml:"sharded_accounts" env:"PG_SHARDED_ACCOUNTS"`
+		UnshardedAccounts []string `yaml:"unsharded_accounts" env:"PG_UNSHARDED_ACCOUNTS"`
+		BalanceShards     int      `env-default:"16" yaml:"balance_shards" env:"PG_BALANCE_SHARDS"`
+		// Deposits made at once are written together, by one statement per
+		// DepositBatchWindow of at most DepositBatchSize deposits. A zero
+		// window writes every deposit by itself. Batching has no effect while
+		// the audit log is enabled, every deposit is written in the unit of
+		// work of its audit entry then.
+		DepositBatchWindow time.Duration `yaml:"deposit_batch_window" env:"PG_DEPOSIT_BATCH_WINDOW"`
+		DepositBatchSize   int           `env-default:"100" yaml:"deposit_batch_size" env:"PG_DEPOSIT_BATCH_SIZE"`
+	}
+
+	// ReconciliationConfig schedules the reconciliation of balances with the ledger,
+	// zero interval disables it. Balances are only ever repaired if AllowRepair is set.
+	ReconciliationConfig struct {
+		Interval    time.Duration `yaml:"interval" env:"RECONCILIATION_INTERVAL"`
+		Repair      bool          `yaml:"repair" env:"RECONCILIATION_REPAIR"`
+		AllowRepair bool          `yaml:"allow_repair" env:"RECONCILIATION_ALLOW_REPAIR"`
+	}
+
+	// SnapshotsConfig schedules the end of day balance snapshots, zero interval disables them.
+	SnapshotsConfig struct {
+		Interval time.Duration `yaml:"interval" env:"SNAPSHOTS_INTERVAL"`
+	}
+
+	// AuthConfig configures the authentication of RPC callers. With auth enabled
+	// every call must carry either a JWT bearer token or one of the API keys,
+	// or come with a verified client certificate if ClientCert is set.
+	AuthConfig struct {
+		Enabled    bool           `env-default:"true" yaml:"enabled" env:"AUTH_ENABLED"`
+		JWT        JWTConfig      `yaml:"jwt"`
+		APIKeys    []APIKeyConfig `yaml:"api_keys"`
+		ClientCert bool           `yaml:"client_cert" env:"AUTH_CLIENT_CERT"`
+	}
+
+	// JWTConfig lists the keys tokens are verified against. Issuer and audience
+	// are only checked if set. Roles are read from the "roles" claim.
+	JWTConfig struct {
+		Issuer         string   `yaml:"issuer" env:"AUTH_JWT_ISSUER"`
+		Audience       string   `yaml:"audience" env:"AUTH_JWT_AUDIENCE"`
+		HMACSecret     string   `yaml:"hmac_secret" env:"AUTH_JWT_HMAC_SECRET"`
+		PublicKeyFiles []string `yaml:"public_key_files" env:"AUTH_JWT_PUBLIC_KEY_FILES"`
+		JWKSFile       string   `yaml:"jwks_file" env:"AUTH_JWT_JWKS_FILE"`
+	}
+
+	// AuthzConfig points to the YAML policy of the roles of authenticated callers.
+	// It is required once authentication is enabled.
+	AuthzConfig struct {
+		PolicyFile string `yaml:"policy_file" env:"AUTHZ_POLICY_FILE"`
+	}
+
+	// RateLimitConfig limits the calls of every caller per method. A caller listed
+	// in Clients gets its own quota for every method, other callers get the limit
+	// of the method in Methods or the Default one. Money-moving calls of all
+	// callers are also limited to MaxConcurrentMoneyMoves running at once.
+	RateLimitConfig struct {
+		Enabled                 bool                   `yaml:"enabled" env:"RATE_LIMIT_ENABLED"`
+		Default                 LimitConfig            `yaml:"default"`
+		Methods                 map[string]LimitConfig `yaml:"methods"`
+		Clients                 map[string]LimitConfig `yaml:"clients"`
+		MaxConcurrentMoneyMoves int                    `yaml:"max_concurrent_money_moves" env:"RATE_LIMIT_MAX_CONCURRENT_MONEY_MOVES"`
+		// MoneyMoveWait is how long a money-moving call waits for a free slot.
+		MoneyMoveWait time.Duration `env-default:"1s" yaml:"money_move_wait" env:"RATE_LIMIT_MONEY_MOVE_WAIT"`
+	}
+
+	// AuditConfig records every state-changing call in the audit log. It turns
+	// off deposit batching, see PostgresConfig.DepositBatchWindow.
+	AuditConfig struct {
+		Enabled bool `env-default:"true" yaml:"enabled" env:"AUDIT_ENABLED"`
+	}
+
+	// LimitConfig is a token bucket refilled with Rate tokens per second
+	// holding up to Burst tokens. Zero rate means no limit.
+	LimitConfig struct {
+		Rate  float64 `yaml:"rate"`
+		Burst int     `yaml:"burst"`
+	}
+
+	APIKeyConfig struct {
+		Name string `yaml:"name"`
+		// SHA256 is the hex encoded hash of the key, the key itself is not stored.
+		SHA256 string   `yaml:"sha256"`
+		Roles  []string `yaml:"roles"`
 	}
 )
 
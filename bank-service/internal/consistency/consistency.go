@@ -0,0 +1,39 @@
+package consistency
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header asks for the reads of a call to see every write committed before it,
+// like the ones of the previous calls of the client, by reading from the primary.
+const Header = "x-read-your-writes"
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withConsistency(ctx), req)
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := middleware.WrapServerStream(ss)
+		stream.WrappedContext = withConsistency(ss.Context())
+
+		return handler(srv, stream)
+	}
+}
+
+// withConsistency marks ctx to read from the primary if the call sent the header set to true.
+func withConsistency(ctx context.Context) context.Context {
+	for _, v := range metadata.ValueFromIncomingContext(ctx, Header) {
+		if v == "true" {
+			return postgres.ReadYourWrites(ctx)
+		}
+	}
+	return ctx
+}
@@ -2,6 +2,8 @@ package bankgrpc
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
 	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
@@ -13,16 +15,63 @@ type Bank interface {
 	CreateAccount(ctx context.Context, account models.Account) (uuid.UUID, error)
 	GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Account, error)
 	DeleteAccount(ctx context.Context, accountUUID uuid.UUID) error
-	Deposit(ctx context.Context, details models.TransactionDetails) error
-	Withdraw(ctx context.Context, details models.TransactionDetails) error
-	Refund(ctx context.Context, details models.TransactionDetails) error
+	ImportAccounts(ctx context.Context, accounts []models.AccountImport) ([]models.AccountImportResult, error)
+	Deposit(ctx context.Context, details models.TransactionDetails) (bool, error)
+	Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error)
+	Refund(ctx context.Context, details models.TransactionDetails) (bool, error)
+	BatchPostings(ctx context.Context, batch models.Batch) (models.BatchResult, error)
+}
+
+type Transfers interface {
+	CreateTransfer(ctx context.Context, transfer models.Transfer) (models.Transfer, error)
+	FailTransfer(ctx context.Context, transferUUID uuid.UUID, status models.TransferStatus, reason string) (models.Transfer, error)
+	GetTransfer(ctx context.Context, transferUUID uuid.UUID) (models.Transfer, error)
+	ListTransfers(ctx context.Context, filter models.TransferFilter) ([]models.Transfer, *models.TransferCursor, error)
+}
+
+type Statements interface {
+	GenerateStatement(ctx context.Context, statement models.Statement, w io.Writer) error
+}
+
+type Reconciliation interface {
+	Reconcile(ctx context.Context, trigger models.ReconciliationTrigger, repair bool) (models.ReconciliationRun, error)
+	GetRun(ctx context.Context, runUUID uuid.UUID) (models.ReconciliationRun, error)
+}
+
+type Snapshots interface {
+	GetBalanceAt(ctx context.Context, accountUUID uuid.UUID, at time.Time) (models.BalanceAt, error)
+}
+
+type AuditLog interface {
+	QueryAuditLog(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, int64, error)
+	VerifyAuditLog(ctx context.Context) (models.AuditVerification, error)
 }
 
 type bankAPI struct {
 	bankv1.UnimplementedBankServer
-	bank Bank
+	bank           Bank
+	transfers      Transfers
+	statements     Statements
+	reconciliation Reconciliation
+	snapshots      Snapshots
+	auditLog       AuditLog
 }
 
-func Register(server *grpc.Server, bank Bank) {
-	bankv1.RegisterBankServer(server, &bankAPI{bank: bank})
+func Register(
+	server *grpc.Server,
+	bank Bank,
+	transfers Transfers,
+	statements Statements,
+	reconciliation Reconciliation,
+	snapshots Snapshots,
+	auditLog AuditLog,
+) {
+	bankv1.RegisterBankServer(server, &bankAPI{
+		bank:           bank,
+		transfers:      transfers,
+		statements:     statements,
+		reconciliation: reconciliation,
+		snapshots:      snapshots,
+		auditLog:       auditLog,
+	})
 }
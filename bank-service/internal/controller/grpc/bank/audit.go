@@ -0,0 +1,120 @@
+package bankgrpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (b *bankAPI) QueryAuditLog(ctx context.Context, in *bankv1.QueryAuditLogRequest) (*bankv1.QueryAuditLogResponse, error) {
+	filter := models.AuditFilter{
+		Subject: in.GetSubject(),
+		Method:  in.GetMethod(),
+		Limit:   int(in.GetPageSize()),
+	}
+
+	if in.GetFrom() != nil {
+		if err := in.GetFrom().CheckValid(); err != nil {
+			return nil, grpcerr.InvalidField("From", "incorrect timestamp")
+		}
+		from := in.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if in.GetTo() != nil {
+		if err := in.GetTo().CheckValid(); err != nil {
+			return nil, grpcerr.InvalidField("To", "incorrect timestamp")
+		}
+		to := in.GetTo().AsTime()
+		filter.To = &to
+	}
+
+	if in.GetPageToken() != "" {
+		afterID, err := decodeAuditPageToken(in.GetPageToken())
+		if err != nil {
+			return nil, grpcerr.ErrIncorrectPageToken
+		}
+		filter.AfterID = afterID
+	}
+
+	entries, next, err := b.auditLog.QueryAuditLog(ctx, filter)
+	if err != nil {
+		return nil, grpcerr.FromService(err)
+	}
+
+	resp := &bankv1.QueryAuditLogResponse{
+		Entries: make([]*bankv1.AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, toAuditEntryProto(entry))
+	}
+
+	if next != 0 {
+		resp.NextPageToken = encodeAuditPageToken(next)
+	}
+
+	return resp, nil
+}
+
+func (b *bankAPI) VerifyAuditLog(ctx context.Context, _ *emptypb.Empty) (*bankv1.VerifyAuditLogResponse, error) {
+	result, err := b.auditLog.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, grpcerr.FromService(err)
+	}
+
+	return &bankv1.VerifyAuditLogResponse{
+		Valid:          result.Valid,
+		Checked:        result.Checked,
+		FirstInvalidID: result.FirstInvalidID,
+		Reason:         result.Reason,
+	}, nil
+}
+
+func toAuditEntryProto(entry models.AuditEntry) *bankv1.AuditEntry {
+	out := &bankv1.AuditEntry{
+		ID:        entry.ID,
+		Subject:   entry.Subject,
+		Method:    entry.Method,
+		Request:   entry.Request,
+		Code:      entry.Code,
+		Error:     entry.Error,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		PrevHash:  hex.EncodeToString(entry.PrevHash),
+		Hash:      hex.EncodeToString(entry.Hash),
+	}
+
+	if entry.Response != nil {
+		out.Response = *entry.Response
+	}
+
+	return out
+}
+
+// Audit page tokens carry the id of the last returned entry.
+func encodeAuditPageToken(afterID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(afterID, 10)))
+}
+
+func decodeAuditPageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	afterID, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if afterID <= 0 {
+		return 0, errors.New("malformed page token")
+	}
+
+	return afterID, nil
+}
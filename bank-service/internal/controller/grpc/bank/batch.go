@@ -0,0 +1,139 @@
+package bankgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+var batchModes = map[bankv1.BatchMode]models.BatchMode{
+	bankv1.BatchMode_BATCH_MODE_ALL_OR_NOTHING: models.BatchAllOrNothing,
+	bankv1.BatchMode_BATCH_MODE_BEST_EFFORT:    models.BatchBestEffort,
+}
+
+var postingStatuses = map[models.BatchItemStatus]bankv1.PostingStatus{
+	models.BatchItemApplied:  bankv1.PostingStatus_POSTING_STATUS_APPLIED,
+	models.BatchItemRejected: bankv1.PostingStatus_POSTING_STATUS_REJECTED,
+	models.BatchItemAborted:  bankv1.PostingStatus_POSTING_STATUS_ABORTED,
+}
+
+func (b *bankAPI) BatchPostings(ctx context.Context, in *bankv1.BatchPostingsRequest) (*bankv1.BatchPostingsResponse, error) {
+	mode, ok := batchModes[in.GetMode()]
+	if !ok {
+		return nil, grpcerr.InvalidField("Mode", "incorrect batch mode")
+	}
+
+	if n := len(in.GetPostings()); n == 0 || n > models.MaxBatchItems {
+		return nil, grpcerr.InvalidField("Postings", fmt.Sprintf("batch must contain from 1 to %d postings", models.MaxBatchItems))
+	}
+
+	batch := models.Batch{
+		Mode:  mode,
+		Items: make([]models.BatchItem, 0, len(in.GetPostings())),
+	}
+
+	// Every incorrect posting is reported, so that a client fixes them all at once.
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, posting := range in.GetPostings() {
+		item, v := toBatchItem(posting)
+		if v != nil {
+			v.Field = fmt.Sprintf("Postings[%d].%s", i, v.Field)
+			violations = append(violations, v)
+			continue
+		}
+		batch.Items = append(batch.Items, item)
+	}
+	if len(violations) > 0 {
+		return nil, grpcerr.BadRequest(violations...)
+	}
+
+	result, err := b.bank.BatchPostings(ctx, batch)
+	if err != nil {
+		return nil, grpcerr.FromService(err)
+	}
+
+	resp := &bankv1.BatchPostingsResponse{
+		BatchUUID: result.UUID.String(),
+		Committed: result.Committed,
+		Results:   make([]*bankv1.BatchPostingResult, 0, len(result.Items)),
+	}
+	for i, item := range result.Items {
+		r := &bankv1.BatchPostingResult{
+			Index:  int32(i),
+			Status: postingStatuses[item.Status],
+		}
+		if item.Err != nil {
+			err := grpcerr.FromService(item.Err, itemRefs(batch.Items[i])...)
+			r.Error = status.Convert(err).Message()
+			r.Reason = grpcerr.Reason(err)
+		}
+		resp.Results = append(resp.Results, r)
+	}
+
+	return resp, nil
+}
+
+// toBatchItem returns the violation of the first incorrect field of the posting,
+// the field is relative to the posting.
+func toBatchItem(posting *bankv1.BatchPosting) (models.BatchItem, *errdetails.BadRequest_FieldViolation) {
+	item := models.BatchItem{Amount: posting.GetAmount()}
+
+	if item.Amount <= 0 {
+		return models.BatchItem{}, violation("Amount", "amount must be positive")
+	}
+
+	var needFrom, needTo bool
+	switch posting.GetType() {
+	case bankv1.PostingType_POSTING_TYPE_DEPOSIT:
+		item.Type, needTo = models.BatchDeposit, true
+	case bankv1.PostingType_POSTING_TYPE_WITHDRAW:
+		item.Type, needFrom = models.BatchWithdraw, true
+	case bankv1.PostingType_POSTING_TYPE_TRANSFER:
+		item.Type, needFrom, needTo = models.BatchTransfer, true, true
+	default:
+		return models.BatchItem{}, violation("Type", "incorrect posting type")
+	}
+
+	var err error
+	if needFrom {
+		if item.FromAccountUUID, err = uuid.Parse(posting.GetFromAccountUUID()); err != nil {
+			return models.BatchItem{}, violation("FromAccountUUID", "incorrect format of fromAccountUUID")
+		}
+	}
+	if needTo {
+		if item.ToAccountUUID, err = uuid.Parse(posting.GetToAccountUUID()); err != nil {
+			return models.BatchItem{}, violation("ToAccountUUID", "incorrect format of toAccountUUID")
+		}
+	}
+
+	if needFrom && needTo && item.FromAccountUUID == item.ToAccountUUID {
+		return models.BatchItem{}, violation("ToAccountUUID", "source and target accounts must differ")
+	}
+
+	return item, nil
+}
+
+// itemRefs names the accounts of a posting, the debited one first.
+func itemRefs(item models.BatchItem) []grpcerr.Ref {
+	var refs []grpcerr.Ref
+	if item.FromAccountUUID != uuid.Nil {
+		refs = append(refs, grpcerr.Account.Named(item.FromAccountUUID.String()))
+	}
+	if item.ToAccountUUID != uuid.Nil {
+		refs = append(refs, grpcerr.Account.Named(item.ToAccountUUID.String()))
+	}
+	return refs
+}
+
+func violation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	}
+}
@@ -2,37 +2,28 @@ package bankgrpc
 
 import (
 	"context"
-	"errors"
+	"unicode/utf8"
 
 	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
 	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
-	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
 	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (b *bankAPI) CreateAccount(ctx context.Context, in *bankv1.CreateAccountRequest) (*bankv1.CreateAccountResponse, error) {
-	if len(in.GetName()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty account name")
-	}
-
-	if in.GetBalance() < 0 {
-		return nil, status.Error(codes.InvalidArgument, "negative balance forbidden")
+	if err := validateAccount(in.GetName(), in.GetOwner(), in.GetBalance()); err != nil {
+		return nil, err
 	}
 
 	accountUUID, err := b.bank.CreateAccount(ctx, models.Account{
 		Name:    in.GetName(),
 		Balance: in.GetBalance(),
+		Owner:   in.GetOwner(),
 	})
 
 	if err != nil {
-		if errors.Is(err, servicerr.ErrAlreadyExist) {
-			return nil, status.Error(codes.AlreadyExists, "account already exist")
-		}
-		return nil, grpcerr.ErrServiceLayer
+		return nil, grpcerr.FromService(err, grpcerr.Account.Named(""))
 	}
 
 	return &bankv1.CreateAccountResponse{AccountUUID: accountUUID.String()}, nil
@@ -46,16 +37,14 @@ func (b *bankAPI) GetAccount(ctx context.Context, in *bankv1.GetAccountRequest)
 
 	account, err := b.bank.GetAccount(ctx, accountUUID)
 	if err != nil {
-		if errors.Is(err, servicerr.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "account not found")
-		}
-		return nil, grpcerr.ErrServiceLayer
+		return nil, grpcerr.FromService(err, grpcerr.Account.Named(accountUUID.String()))
 	}
 
 	return &bankv1.GetAccountResponse{
 		AccountUUID: account.UUID.String(),
 		Name:        account.Name,
 		Balance:     account.Balance,
+		Owner:       account.Owner,
 	}, nil
 }
 
@@ -66,10 +55,7 @@ func (b *bankAPI) DeleteAccount(ctx context.Context, in *bankv1.DeleteAccountReq
 	}
 
 	if err := b.bank.DeleteAccount(ctx, accountUUID); err != nil {
-		if errors.Is(err, servicerr.ErrNotFound) {
-			return &emptypb.Empty{}, status.Error(codes.NotFound, "account not found")
-		}
-		return &emptypb.Empty{}, grpcerr.ErrServiceLayer
+		return &emptypb.Empty{}, grpcerr.FromService(err, grpcerr.Account.Named(accountUUID.String()))
 	}
 
 	return &emptypb.Empty{}, nil
@@ -85,14 +71,18 @@ func (b *bankAPI) Deposit(ctx context.Context, in *bankv1.DepositRequest) (*empt
 		return &emptypb.Empty{}, grpcerr.ErrIncorrectAmount
 	}
 
-	if err := b.bank.Deposit(ctx, models.TransactionDetails{
+	transferUUID, err := parseOptionalUUID(in.GetTransferUUID())
+	if err != nil {
+		return &emptypb.Empty{}, grpcerr.ErrParseTransferUUID
+	}
+
+	details := models.TransactionDetails{
 		TargetAccountUUID: accountUUID,
 		Amount:            in.GetAmount(),
-	}); err != nil {
-		if errors.Is(err, servicerr.ErrNotFound) {
-			return &emptypb.Empty{}, grpcerr.ErrAccountNotFound
-		}
-		return &emptypb.Empty{}, grpcerr.ErrServiceLayer
+		TransferUUID:      transferUUID,
+	}
+	if _, err := b.bank.Deposit(ctx, details); err != nil {
+		return &emptypb.Empty{}, balanceError(err, details)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -108,14 +98,18 @@ func (b *bankAPI) Withdraw(ctx context.Context, in *bankv1.WithdrawRequest) (*em
 		return &emptypb.Empty{}, grpcerr.ErrIncorrectAmount
 	}
 
-	if err := b.bank.Withdraw(ctx, models.TransactionDetails{
+	transferUUID, err := parseOptionalUUID(in.GetTransferUUID())
+	if err != nil {
+		return &emptypb.Empty{}, grpcerr.ErrParseTransferUUID
+	}
+
+	details := models.TransactionDetails{
 		TargetAccountUUID: accountUUID,
 		Amount:            in.GetAmount(),
-	}); err != nil {
-		if errors.Is(err, servicerr.ErrNotFound) {
-			return &emptypb.Empty{}, grpcerr.ErrAccountNotFound
-		}
-		return &emptypb.Empty{}, grpcerr.ErrServiceLayer
+		TransferUUID:      transferUUID,
+	}
+	if _, err := b.bank.Withdraw(ctx, details); err != nil {
+		return &emptypb.Empty{}, balanceError(err, details)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -131,15 +125,57 @@ func (b *bankAPI) Refund(ctx context.Context, in *bankv1.RefundRequest) (*emptyp
 		return &emptypb.Empty{}, grpcerr.ErrIncorrectAmount
 	}
 
-	if err := b.bank.Refund(ctx, models.TransactionDetails{
+	transferUUID, err := parseOptionalUUID(in.GetTransferUUID())
+	if err != nil {
+		return &emptypb.Empty{}, grpcerr.ErrParseTransferUUID
+	}
+
+	details := models.TransactionDetails{
 		TargetAccountUUID: accountUUID,
 		Amount:            in.GetAmount(),
-	}); err != nil {
-		if errors.Is(err, servicerr.ErrNotFound) {
-			return &emptypb.Empty{}, grpcerr.ErrAccountNotFound
-		}
-		return &emptypb.Empty{}, grpcerr.ErrServiceLayer
+		TransferUUID:      transferUUID,
+	}
+	if _, err := b.bank.Refund(ctx, details); err != nil {
+		return &emptypb.Empty{}, balanceError(err, details)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// balanceError maps the service errors of Deposit, Withdraw and Refund to gRPC statuses,
+// the transfer is named by the movements that advance one.
+func balanceError(err error, details models.TransactionDetails) error {
+	refs := []grpcerr.Ref{grpcerr.Account.Named(details.TargetAccountUUID.String())}
+	if details.TransferUUID != uuid.Nil {
+		refs = append(refs, grpcerr.Transfer.Named(details.TransferUUID.String()))
+	}
+	return grpcerr.FromService(err, refs...)
+}
+
+func parseOptionalUUID(s string) (uuid.UUID, error) {
+	if s == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(s)
+}
+
+func validateAccount(name, owner string, balance int64) error {
+	if len(name) == 0 {
+		return grpcerr.InvalidField("Name", "empty account name")
+	}
+
+	// account_name is a varchar(255), which counts characters rather than bytes.
+	if utf8.RuneCountInString(name) > 255 {
+		return grpcerr.InvalidField("Name", "account name is too long")
+	}
+
+	if utf8.RuneCountInString(owner) > 255 {
+		return grpcerr.InvalidField("Owner", "owner is too long")
+	}
+
+	if balance < 0 {
+		return grpcerr.InvalidField("Balance", "negative balance forbidden")
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package bankgrpc
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Rows are stored in chunks, each chunk is copied in its own transaction.
+	_importChunkSize = 1000
+	_maxImportErrors = 1000
+)
+
+func (b *bankAPI) ImportAccounts(stream bankv1.Bank_ImportAccountsServer) error {
+	ctx := stream.Context()
+	resp := &bankv1.ImportAccountsResponse{}
+	chunk := make([]models.AccountImport, 0, _importChunkSize)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		results, err := b.bank.ImportAccounts(ctx, chunk)
+		if err != nil {
+			return grpcerr.FromService(err)
+		}
+
+		for _, result := range results {
+			if result.Skipped {
+				resp.Skipped++
+			} else {
+				resp.Imported++
+			}
+		}
+		resp.LastExternalID = chunk[len(chunk)-1].ExternalID
+		chunk = chunk[:0]
+
+		return nil
+	}
+
+	for row := int64(0); ; row++ {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := validateImportRow(in); err != nil {
+			resp.Failed++
+			if len(resp.Errors) < _maxImportErrors {
+				resp.Errors = append(resp.Errors, &bankv1.ImportAccountError{
+					Row:        row,
+					ExternalID: in.GetExternalID(),
+					Error:      status.Convert(err).Message(),
+					Reason:     grpcerr.Reason(err),
+					Field:      grpcerr.Field(err),
+				})
+			}
+			continue
+		}
+
+		chunk = append(chunk, models.AccountImport{
+			ExternalID: in.GetExternalID(),
+			Name:       in.GetName(),
+			Balance:    in.GetBalance(),
+			Owner:      in.GetOwner(),
+		})
+
+		if len(chunk) == _importChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func validateImportRow(in *bankv1.ImportAccountRow) error {
+	if len(in.GetExternalID()) == 0 {
+		return grpcerr.InvalidField("ExternalID", "empty external id")
+	}
+
+	if utf8.RuneCountInString(in.GetExternalID()) > 255 {
+		return grpcerr.InvalidField("ExternalID", "external id is too long")
+	}
+
+	return validateAccount(in.GetName(), in.GetOwner(), in.GetBalance())
+}
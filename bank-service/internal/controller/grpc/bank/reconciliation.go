@@ -0,0 +1,72 @@
+package bankgrpc
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var reconciliationTriggers = map[models.ReconciliationTrigger]bankv1.ReconciliationTrigger{
+	models.ReconciliationManual:    bankv1.ReconciliationTrigger_RECONCILIATION_TRIGGER_MANUAL,
+	models.ReconciliationScheduled: bankv1.ReconciliationTrigger_RECONCILIATION_TRIGGER_SCHEDULED,
+}
+
+var reconciliationStatuses = map[models.ReconciliationStatus]bankv1.ReconciliationStatus{
+	models.ReconciliationRunning:   bankv1.ReconciliationStatus_RECONCILIATION_STATUS_RUNNING,
+	models.ReconciliationCompleted: bankv1.ReconciliationStatus_RECONCILIATION_STATUS_COMPLETED,
+	models.ReconciliationFailed:    bankv1.ReconciliationStatus_RECONCILIATION_STATUS_FAILED,
+}
+
+func (b *bankAPI) ReconcileBalances(ctx context.Context, in *bankv1.ReconcileBalancesRequest) (*bankv1.ReconciliationRun, error) {
+	run, err := b.reconciliation.Reconcile(ctx, models.ReconciliationManual, in.GetRepair())
+	if err != nil {
+		return nil, grpcerr.FromService(err)
+	}
+
+	return toReconciliationRunProto(run), nil
+}
+
+func (b *bankAPI) GetReconciliationRun(ctx context.Context, in *bankv1.GetReconciliationRunRequest) (*bankv1.ReconciliationRun, error) {
+	runUUID, err := uuid.Parse(in.GetRunUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseRunUUID
+	}
+
+	run, err := b.reconciliation.GetRun(ctx, runUUID)
+	if err != nil {
+		return nil, grpcerr.FromService(err, grpcerr.ReconciliationRun.Named(runUUID.String()))
+	}
+
+	return toReconciliationRunProto(run), nil
+}
+
+func toReconciliationRunProto(run models.ReconciliationRun) *bankv1.ReconciliationRun {
+	out := &bankv1.ReconciliationRun{
+		RunUUID:         run.UUID.String(),
+		Trigger:         reconciliationTriggers[run.Trigger],
+		Status:          reconciliationStatuses[run.Status],
+		Repair:          run.Repair,
+		AccountsChecked: run.AccountsChecked,
+		Error:           run.Error,
+		StartedAt:       timestamppb.New(run.StartedAt),
+	}
+
+	if run.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*run.FinishedAt)
+	}
+
+	for _, m := range run.Mismatches {
+		out.Mismatches = append(out.Mismatches, &bankv1.BalanceMismatch{
+			AccountUUID:   m.AccountUUID.String(),
+			Balance:       m.Balance,
+			LedgerBalance: m.LedgerBalance,
+			Repaired:      m.Repaired,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,32 @@
+package bankgrpc
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (b *bankAPI) GetBalanceAt(ctx context.Context, in *bankv1.GetBalanceAtRequest) (*bankv1.GetBalanceAtResponse, error) {
+	accountUUID, err := uuid.Parse(in.GetAccountUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseUUID
+	}
+
+	if err := in.GetAt().CheckValid(); err != nil {
+		return nil, grpcerr.InvalidField("At", "incorrect timestamp")
+	}
+
+	balance, err := b.snapshots.GetBalanceAt(ctx, accountUUID, in.GetAt().AsTime())
+	if err != nil {
+		return nil, grpcerr.FromService(err, grpcerr.Account.Named(accountUUID.String()))
+	}
+
+	return &bankv1.GetBalanceAtResponse{
+		AccountUUID: balance.AccountUUID.String(),
+		At:          timestamppb.New(balance.At),
+		Balance:     balance.Balance,
+	}, nil
+}
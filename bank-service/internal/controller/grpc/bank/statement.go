@@ -0,0 +1,78 @@
+package bankgrpc
+
+import (
+	"bufio"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const _statementChunkSize = 64 << 10
+
+var statementFormats = map[bankv1.StatementFormat]models.StatementFormat{
+	bankv1.StatementFormat_STATEMENT_FORMAT_CSV:  models.StatementCSV,
+	bankv1.StatementFormat_STATEMENT_FORMAT_JSON: models.StatementJSON,
+}
+
+func (b *bankAPI) GenerateStatement(in *bankv1.GenerateStatementRequest, stream bankv1.Bank_GenerateStatementServer) error {
+	accountUUID, err := uuid.Parse(in.GetAccountUUID())
+	if err != nil {
+		return grpcerr.ErrParseUUID
+	}
+
+	format, ok := statementFormats[in.GetFormat()]
+	if !ok {
+		return grpcerr.InvalidField("Format", "incorrect statement format")
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if in.GetFrom().CheckValid() != nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "From",
+			Description: "statement period is required",
+		})
+	}
+	if in.GetTo().CheckValid() != nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "To",
+			Description: "statement period is required",
+		})
+	}
+	if len(violations) > 0 {
+		return grpcerr.BadRequest(violations...)
+	}
+
+	from, to := in.GetFrom().AsTime(), in.GetTo().AsTime()
+	if !from.Before(to) {
+		return grpcerr.InvalidField("To", "statement period must end after it starts")
+	}
+
+	w := bufio.NewWriterSize(chunkWriter{stream}, _statementChunkSize)
+
+	err = b.statements.GenerateStatement(stream.Context(), models.Statement{
+		AccountUUID: accountUUID,
+		From:        from,
+		To:          to,
+		Format:      format,
+	}, w)
+	if err != nil {
+		return grpcerr.FromService(err, grpcerr.Account.Named(accountUUID.String()))
+	}
+
+	return w.Flush()
+}
+
+// chunkWriter sends every write as a statement chunk.
+type chunkWriter struct {
+	stream bankv1.Bank_GenerateStatementServer
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&bankv1.StatementChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,218 @@
+package bankgrpc
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var transferStatuses = map[bankv1.TransferStatus]models.TransferStatus{
+	bankv1.TransferStatus_TRANSFER_STATUS_PENDING:      models.TransferPending,
+	bankv1.TransferStatus_TRANSFER_STATUS_DEBITED:      models.TransferDebited,
+	bankv1.TransferStatus_TRANSFER_STATUS_COMPLETED:    models.TransferCompleted,
+	bankv1.TransferStatus_TRANSFER_STATUS_COMPENSATING: models.TransferCompensating,
+	bankv1.TransferStatus_TRANSFER_STATUS_FAILED:       models.TransferFailed,
+	bankv1.TransferStatus_TRANSFER_STATUS_REFUNDED:     models.TransferRefunded,
+}
+
+func (b *bankAPI) CreateTransfer(ctx context.Context, in *bankv1.CreateTransferRequest) (*bankv1.Transfer, error) {
+	transferUUID, err := parseOptionalUUID(in.GetTransferUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseTransferUUID
+	}
+
+	from, err := uuid.Parse(in.GetFromAccountUUID())
+	if err != nil {
+		return nil, grpcerr.InvalidField("FromAccountUUID", "incorrect format of fromAccountUUID")
+	}
+
+	to, err := uuid.Parse(in.GetToAccountUUID())
+	if err != nil {
+		return nil, grpcerr.InvalidField("ToAccountUUID", "incorrect format of toAccountUUID")
+	}
+
+	if from == to {
+		return nil, grpcerr.InvalidField("ToAccountUUID", "source and target accounts must differ")
+	}
+
+	if in.GetAmount() <= 0 {
+		return nil, grpcerr.ErrIncorrectAmount
+	}
+
+	transfer, err := b.transfers.CreateTransfer(ctx, models.Transfer{
+		UUID:            transferUUID,
+		FromAccountUUID: from,
+		ToAccountUUID:   to,
+		Amount:          in.GetAmount(),
+	})
+	if err != nil {
+		// A missing account is the only not found error, the transfer is created by the call.
+		if errors.Is(err, servicerr.ErrNotFound) {
+			return nil, grpcerr.NotFound(grpcerr.Account.Named(""))
+		}
+		return nil, grpcerr.FromService(err, grpcerr.Transfer.Named(in.GetTransferUUID()))
+	}
+
+	return toTransferProto(transfer), nil
+}
+
+func (b *bankAPI) FailTransfer(ctx context.Context, in *bankv1.FailTransferRequest) (*bankv1.Transfer, error) {
+	transferUUID, err := uuid.Parse(in.GetTransferUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseTransferUUID
+	}
+
+	st := transferStatuses[in.GetStatus()]
+	if st != models.TransferFailed && st != models.TransferCompensating {
+		return nil, grpcerr.ErrIncorrectStatus
+	}
+
+	transfer, err := b.transfers.FailTransfer(ctx, transferUUID, st, in.GetReason())
+	if err != nil {
+		return nil, grpcerr.FromService(err, grpcerr.Transfer.Named(transferUUID.String()))
+	}
+
+	return toTransferProto(transfer), nil
+}
+
+func (b *bankAPI) GetTransfer(ctx context.Context, in *bankv1.GetTransferRequest) (*bankv1.Transfer, error) {
+	transferUUID, err := uuid.Parse(in.GetTransferUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseTransferUUID
+	}
+
+	transfer, err := b.transfers.GetTransfer(ctx, transferUUID)
+	if err != nil {
+		return nil, grpcerr.FromService(err, grpcerr.Transfer.Named(transferUUID.String()))
+	}
+
+	return toTransferProto(transfer), nil
+}
+
+func (b *bankAPI) ListTransfers(ctx context.Context, in *bankv1.ListTransfersRequest) (*bankv1.ListTransfersResponse, error) {
+	accountUUID, err := parseOptionalUUID(in.GetAccountUUID())
+	if err != nil {
+		return nil, grpcerr.ErrParseUUID
+	}
+
+	filter := models.TransferFilter{
+		AccountUUID: accountUUID,
+		Limit:       int(in.GetPageSize()),
+	}
+
+	if in.GetStatus() != bankv1.TransferStatus_TRANSFER_STATUS_UNSPECIFIED {
+		st, ok := transferStatuses[in.GetStatus()]
+		if !ok {
+			return nil, grpcerr.ErrIncorrectStatus
+		}
+		filter.Status = st
+	}
+
+	if in.GetPageToken() != "" {
+		cursor, err := decodePageToken(in.GetPageToken())
+		if err != nil {
+			return nil, grpcerr.ErrIncorrectPageToken
+		}
+		filter.After = &cursor
+	}
+
+	transfers, next, err := b.transfers.ListTransfers(ctx, filter)
+	if err != nil {
+		return nil, grpcerr.FromService(err)
+	}
+
+	resp := &bankv1.ListTransfersResponse{
+		Transfers: make([]*bankv1.Transfer, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, toTransferProto(transfer))
+	}
+
+	if next != nil {
+		resp.NextPageToken = encodePageToken(*next)
+	}
+
+	return resp, nil
+}
+
+func toTransferProto(transfer models.Transfer) *bankv1.Transfer {
+	out := &bankv1.Transfer{
+		TransferUUID:    transfer.UUID.String(),
+		FromAccountUUID: transfer.FromAccountUUID.String(),
+		ToAccountUUID:   transfer.ToAccountUUID.String(),
+		Amount:          transfer.Amount,
+		Status:          toTransferStatusProto(transfer.Status),
+		FailureReason:   transfer.FailureReason,
+		CreatedAt:       timestamppb.New(transfer.CreatedAt),
+	}
+
+	if transfer.UpdatedAt != nil {
+		out.UpdatedAt = timestamppb.New(*transfer.UpdatedAt)
+	}
+
+	for _, event := range transfer.Events {
+		e := &bankv1.TransferEvent{
+			ToStatus:  toTransferStatusProto(event.ToStatus),
+			Reason:    event.Reason,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+		}
+		if event.FromStatus != nil {
+			e.FromStatus = toTransferStatusProto(*event.FromStatus)
+		}
+		out.Events = append(out.Events, e)
+	}
+
+	return out
+}
+
+func toTransferStatusProto(st models.TransferStatus) bankv1.TransferStatus {
+	for p, m := range transferStatuses {
+		if m == st {
+			return p
+		}
+	}
+	return bankv1.TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+// Page tokens are opaque to clients and carry the position of the last returned transfer.
+func encodePageToken(cursor models.TransferCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + "/" + cursor.UUID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (models.TransferCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return models.TransferCursor{}, err
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "/")
+	if !ok {
+		return models.TransferCursor{}, errors.New("malformed page token")
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return models.TransferCursor{}, err
+	}
+
+	transferUUID, err := uuid.Parse(id)
+	if err != nil {
+		return models.TransferCursor{}, err
+	}
+
+	return models.TransferCursor{
+		CreatedAt: time.Unix(0, n).UTC(),
+		UUID:      transferUUID,
+	}, nil
+}
@@ -1,13 +1,245 @@
 package grpcerr
 
 import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain of the ErrorInfo details of the bank service errors.
+const Domain = "bank.money-transfer"
+
+// Reasons of the ErrorInfo details. Every error of the service carries one,
+// they are stable and clients match on them rather than on the messages.
+const (
+	ReasonInvalidArgument                = "INVALID_ARGUMENT"
+	ReasonAccountNotFound                = "ACCOUNT_NOT_FOUND"
+	ReasonAccountAlreadyExists           = "ACCOUNT_ALREADY_EXISTS"
+	ReasonTransferNotFound               = "TRANSFER_NOT_FOUND"
+	ReasonTransferAlreadyExists          = "TRANSFER_ALREADY_EXISTS"
+	ReasonReconciliationRunNotFound      = "RECONCILIATION_RUN_NOT_FOUND"
+	ReasonReconciliationRunAlreadyExists = "RECONCILIATION_RUN_ALREADY_EXISTS"
+	ReasonInsufficientFunds              = "INSUFFICIENT_FUNDS"
+	ReasonIllegalTransferStatus          = "ILLEGAL_TRANSFER_STATUS_TRANSITION"
+	ReasonTransferMismatch               = "TRANSFER_MISMATCH"
+	ReasonLedgerMismatch                 = "LEDGER_MISMATCH"
+	ReasonPermissionDenied               = "PERMISSION_DENIED"
+	ReasonDeadlineExceeded               = "DEADLINE_EXCEEDED"
+	ReasonCanceled                       = "CANCELED"
+	ReasonInternal                       = "INTERNAL"
+
+	ReasonCredentialsMissing    = "UNAUTHENTICATED_CREDENTIALS_MISSING"
+	ReasonTokenInvalid          = "UNAUTHENTICATED_TOKEN_INVALID"
+	ReasonAPIKeyInvalid         = "UNAUTHENTICATED_API_KEY_INVALID"
+	ReasonUnauthenticated       = "UNAUTHENTICATED"
+	ReasonMethodDenied          = "PERMISSION_DENIED_METHOD"
+	ReasonAccountDenied         = "PERMISSION_DENIED_ACCOUNT"
+	ReasonAccountMissing        = "PERMISSION_DENIED_NO_ACCOUNT"
+	ReasonOpeningBalanceDenied  = "PERMISSION_DENIED_OPENING_BALANCE"
+	ReasonRateLimited           = "RATE_LIMITED"
+	ReasonTooManyMoneyMoves     = "TOO_MANY_CONCURRENT_MONEY_MOVES"
+	ReasonAuditEntryNotRecorded = "AUDIT_ENTRY_NOT_RECORDED"
+)
+
+// Types of the PreconditionFailure violations.
+const (
+	PreconditionBalance        = "BALANCE"
+	PreconditionTransferStatus = "TRANSFER_STATUS"
+	PreconditionTransfer       = "TRANSFER"
+)
+
+// Resource is a kind of entity the errors may refer to.
+type Resource struct {
+	// Type of the ResourceInfo details, the name of the proto message.
+	Type          string
+	noun          string
+	notFound      string
+	alreadyExists string
+}
+
+var (
+	Account = Resource{
+		Type:          "bank.Account",
+		noun:          "account",
+		notFound:      ReasonAccountNotFound,
+		alreadyExists: ReasonAccountAlreadyExists,
+	}
+	Transfer = Resource{
+		Type:          "bank.Transfer",
+		noun:          "transfer",
+		notFound:      ReasonTransferNotFound,
+		alreadyExists: ReasonTransferAlreadyExists,
+	}
+	ReconciliationRun = Resource{
+		Type:          "bank.ReconciliationRun",
+		noun:          "reconciliation run",
+		notFound:      ReasonReconciliationRunNotFound,
+		alreadyExists: ReasonReconciliationRunAlreadyExists,
+	}
 )
 
+// Ref names a single resource, the name is empty if the request does not identify it.
+type Ref struct {
+	Resource Resource
+	Name     string
+}
+
+func (r Resource) Named(name string) Ref {
+	return Ref{Resource: r, Name: name}
+}
+
+// subject of a PreconditionFailure violation about the resource.
+func (r Ref) subject() string {
+	if r.Name == "" {
+		return r.Resource.Type
+	}
+	return r.Resource.Type + "/" + r.Name
+}
+
 var (
-	ErrParseUUID       = status.Error(codes.InvalidArgument, "incorrect format of acountUUID")
-	ErrIncorrectAmount = status.Error(codes.InvalidArgument, "incorrect ammount")
-	ErrAccountNotFound = status.Error(codes.NotFound, "account not found")
-	ErrServiceLayer    = status.Error(codes.Internal, "service layer error")
+	ErrParseUUID          = InvalidField("AccountUUID", "incorrect format of accountUUID")
+	ErrParseTransferUUID  = InvalidField("TransferUUID", "incorrect format of transferUUID")
+	ErrParseRunUUID       = InvalidField("RunUUID", "incorrect format of runUUID")
+	ErrIncorrectAmount    = InvalidField("Amount", "amount must be positive")
+	ErrIncorrectStatus    = InvalidField("Status", "incorrect transfer status")
+	ErrIncorrectPageToken = InvalidField("PageToken", "incorrect page token")
+	ErrInternal           = newError(codes.Internal, "internal error", errorInfo(ReasonInternal, nil))
 )
+
+// InvalidField reports a single incorrect field of the request.
+func InvalidField(field, description string) error {
+	return BadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// BadRequest reports all the incorrect fields of the request at once.
+func BadRequest(violations ...*errdetails.BadRequest_FieldViolation) error {
+	msg := "invalid argument"
+	switch len(violations) {
+	case 0:
+	case 1:
+		msg = violations[0].GetDescription()
+	default:
+		msg = fmt.Sprintf("%s, and %d more violations", violations[0].GetDescription(), len(violations)-1)
+	}
+
+	var metadata map[string]string
+	if len(violations) > 0 {
+		metadata = map[string]string{"field": violations[0].GetField()}
+	}
+
+	return newError(codes.InvalidArgument, msg,
+		errorInfo(ReasonInvalidArgument, metadata),
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+}
+
+func NotFound(ref Ref) error {
+	return newError(codes.NotFound, ref.Resource.noun+" not found",
+		errorInfo(ref.Resource.notFound, resourceMetadata(ref)),
+		resourceInfo(ref),
+	)
+}
+
+func AlreadyExists(ref Ref) error {
+	return newError(codes.AlreadyExists, ref.Resource.noun+" already exist",
+		errorInfo(ref.Resource.alreadyExists, resourceMetadata(ref)),
+		resourceInfo(ref),
+	)
+}
+
+// Unauthenticated reports a caller whose credentials are missing or not valid.
+func Unauthenticated(reason, msg string) error {
+	return newError(codes.Unauthenticated, msg, errorInfo(reason, nil))
+}
+
+// PermissionDenied reports a call the caller is not allowed to make.
+func PermissionDenied(reason, msg string) error {
+	return newError(codes.PermissionDenied, msg, errorInfo(reason, nil))
+}
+
+// ResourceExhausted reports a call rejected by a limit, which may be retried after delay.
+func ResourceExhausted(reason, msg string, delay time.Duration) error {
+	return newError(codes.ResourceExhausted, msg,
+		errorInfo(reason, nil),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+	)
+}
+
+// Internal reports a failure of the server more specific than ErrInternal.
+func Internal(reason, msg string) error {
+	return newError(codes.Internal, msg, errorInfo(reason, nil))
+}
+
+// FailedPrecondition reports a state of the resource that does not allow the call.
+func FailedPrecondition(reason, violationType string, ref Ref, description string) error {
+	return newError(codes.FailedPrecondition, description,
+		errorInfo(reason, resourceMetadata(ref)),
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        violationType,
+				Subject:     ref.subject(),
+				Description: description,
+			}},
+		},
+	)
+}
+
+func errorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	}
+}
+
+func resourceInfo(ref Ref) *errdetails.ResourceInfo {
+	return &errdetails.ResourceInfo{
+		ResourceType: ref.Resource.Type,
+		ResourceName: ref.Name,
+	}
+}
+
+func resourceMetadata(ref Ref) map[string]string {
+	if ref.Name == "" {
+		return nil
+	}
+	return map[string]string{"resource": ref.subject()}
+}
+
+// newError builds a status with details. Attaching the details fails only
+// for an OK code or details that cannot be marshaled, neither happens here.
+func newError(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		panic(fmt.Sprintf("grpcerr: %v", err))
+	}
+	return st.Err()
+}
+
+// Reason returns the ErrorInfo reason of a status error, or an empty string if it has none.
+func Reason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+// Field returns the first incorrect field of a status error, or an empty string if it has none.
+func Field(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok && len(br.GetFieldViolations()) > 0 {
+			return br.GetFieldViolations()[0].GetField()
+		}
+	}
+	return ""
+}
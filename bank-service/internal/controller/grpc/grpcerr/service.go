@@ -0,0 +1,66 @@
+package grpcerr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"google.golang.org/grpc/codes"
+)
+
+// FromService maps an error of the service layer to a status with details.
+// refs are the resources named by the request, the not found and precondition
+// errors refer to the first of them of the matching kind, or to the first one.
+// Unexpected errors are reported as ErrInternal, without their cause.
+func FromService(err error, refs ...Ref) error {
+	var field *servicerr.FieldError
+
+	switch {
+	case errors.As(err, &field):
+		return InvalidField(field.Field, field.Description)
+	case errors.Is(err, servicerr.ErrInvalidArgument):
+		return BadRequest()
+	case errors.Is(err, servicerr.ErrNotFound):
+		return NotFound(pick(refs, Account))
+	case errors.Is(err, servicerr.ErrAlreadyExist):
+		return AlreadyExists(pick(refs, Account))
+	case errors.Is(err, servicerr.ErrInsufficientFunds):
+		return FailedPrecondition(ReasonInsufficientFunds, PreconditionBalance,
+			pick(refs, Account), "insufficient funds")
+	case errors.Is(err, servicerr.ErrIllegalTransition):
+		return FailedPrecondition(ReasonIllegalTransferStatus, PreconditionTransferStatus,
+			pick(refs, Transfer), "illegal transfer status transition")
+	case errors.Is(err, servicerr.ErrTransferMismatch):
+		return FailedPrecondition(ReasonTransferMismatch, PreconditionTransfer,
+			pick(refs, Transfer), "movement does not match transfer")
+	case errors.Is(err, servicerr.ErrLedgerMismatch):
+		return newError(codes.DataLoss, "ledger does not match stored balance",
+			errorInfo(ReasonLedgerMismatch, resourceMetadata(pick(refs, Account))))
+	case errors.Is(err, servicerr.ErrPermissionDenied):
+		return newError(codes.PermissionDenied, err.Error(),
+			errorInfo(ReasonPermissionDenied, nil))
+	case errors.Is(err, context.DeadlineExceeded):
+		return newError(codes.DeadlineExceeded, "deadline exceeded",
+			errorInfo(ReasonDeadlineExceeded, nil))
+	case errors.Is(err, context.Canceled):
+		return newError(codes.Canceled, "request canceled",
+			errorInfo(ReasonCanceled, nil))
+	}
+
+	return ErrInternal
+}
+
+// pick returns the first ref of the resource kind, falling back to the first
+// ref at all. Not found errors of a call on a transfer name the transfer, even
+// if the service did not say which of the resources is missing.
+func pick(refs []Ref, kind Resource) Ref {
+	for _, ref := range refs {
+		if ref.Resource.Type == kind.Type {
+			return ref
+		}
+	}
+	if len(refs) > 0 {
+		return refs[0]
+	}
+	return kind.Named("")
+}
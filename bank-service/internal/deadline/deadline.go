@@ -0,0 +1,106 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Enforcer caps the deadline of every call at the timeout of its method.
+// A client may ask for a shorter deadline, but never for a longer one,
+// and calls without a deadline get one. Calls of other services, like
+// health checks, are capped at the default timeout too.
+type Enforcer struct {
+	timeout time.Duration
+	methods map[string]time.Duration
+}
+
+// New takes the default timeout and the overrides of the Bank methods by name,
+// a zero override lifts the cap of the method.
+func New(timeout time.Duration, methods map[string]time.Duration) (*Enforcer, error) {
+	const op = "deadline.New"
+
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s: timeout must be positive", op)
+	}
+
+	known := make(map[string]bool)
+	for _, m := range bankv1.Bank_ServiceDesc.Methods {
+		known[m.MethodName] = true
+	}
+	for _, s := range bankv1.Bank_ServiceDesc.Streams {
+		known[s.StreamName] = true
+	}
+
+	e := &Enforcer{
+		timeout: timeout,
+		methods: make(map[string]time.Duration, len(methods)),
+	}
+	for name, t := range methods {
+		if !known[name] {
+			return nil, fmt.Errorf("%s: unknown method %q", op, name)
+		}
+		if t < 0 {
+			return nil, fmt.Errorf("%s: negative timeout of %s", op, name)
+		}
+		fullMethod := "/" + bankv1.Bank_ServiceDesc.ServiceName + "/" + name
+		e.methods[fullMethod] = t
+	}
+
+	return e, nil
+}
+
+func (e *Enforcer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, cancel := e.withDeadline(ctx, info.FullMethod)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		return resp, deadlineErr(ctx, err)
+	}
+}
+
+func (e *Enforcer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := e.withDeadline(ss.Context(), info.FullMethod)
+		defer cancel()
+
+		stream := middleware.WrapServerStream(ss)
+		stream.WrappedContext = ctx
+
+		return deadlineErr(ctx, handler(srv, stream))
+	}
+}
+
+func (e *Enforcer) withDeadline(ctx context.Context, fullMethod string) (context.Context, context.CancelFunc) {
+	timeout, ok := e.methods[fullMethod]
+	if !ok {
+		timeout = e.timeout
+	}
+	if timeout == 0 {
+		return ctx, func() {}
+	}
+
+	// WithTimeout keeps the deadline of the client if it is the earlier one.
+	return context.WithTimeout(ctx, timeout)
+}
+
+// deadlineErr reports the calls that failed because they ran out of time as
+// such, rather than as the internal errors the handlers see them as.
+func deadlineErr(ctx context.Context, err error) error {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	if code := status.Code(err); code != codes.Internal && code != codes.Unknown {
+		return err
+	}
+	return grpcerr.FromService(ctx.Err())
+}
@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	grpchealth "google.golang.org/grpc/health"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Checker pings the database periodically and reports the service as serving
+// only while the database is reachable. Once shutting down it never serves again.
+type Checker struct {
+	log      *slog.Logger
+	db       Pinger
+	server   *grpchealth.Server
+	interval time.Duration
+	timeout  time.Duration
+
+	ready        atomic.Bool
+	shuttingDown atomic.Bool
+	mu           sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func New(log *slog.Logger, db Pinger, server *grpchealth.Server, interval, timeout time.Duration) *Checker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Checker{
+		log:      log,
+		db:       db,
+		server:   server,
+		interval: interval,
+		timeout:  timeout,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	// Nothing is served until the first ping succeeds.
+	c.setStatus(healthv1.HealthCheckResponse_NOT_SERVING)
+
+	return c
+}
+
+// Run pings the database every interval and blocks until the checker is stopped.
+func (c *Checker) Run() error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check()
+
+		select {
+		case <-c.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) check() {
+	ctx, cancel := context.WithTimeout(c.ctx, c.timeout)
+	defer cancel()
+
+	err := c.db.Ping(ctx)
+	if c.ctx.Err() != nil {
+		return
+	}
+
+	ready := err == nil
+	if c.ready.Swap(ready) != ready {
+		if ready {
+			c.log.Info("database is reachable, serving")
+		} else {
+			c.log.Error("database is unreachable, not serving", slog.Any("err", err))
+		}
+	}
+
+	if ready {
+		c.setStatus(healthv1.HealthCheckResponse_SERVING)
+	} else {
+		c.setStatus(healthv1.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (c *Checker) setStatus(status healthv1.HealthCheckResponse_ServingStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shuttingDown.Load() {
+		return
+	}
+
+	// The empty service stands for the server as a whole.
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(bankv1.Bank_ServiceDesc.ServiceName, status)
+}
+
+// Shutdown reports the service as not serving for good, so load balancers
+// stop sending new calls before the server stops.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shuttingDown.Store(true)
+	c.server.Shutdown()
+}
+
+// Stop ends the periodic pings.
+func (c *Checker) Stop() {
+	c.cancel()
+}
+
+// LivenessHandler answers as long as the process is able to serve HTTP.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadinessHandler answers with 503 while the database is unreachable or the service is shutting down.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch {
+		case c.shuttingDown.Load():
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("shutting down\n"))
+		case !c.ready.Load():
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("database unreachable\n"))
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("ok\n"))
+		}
+	})
+}
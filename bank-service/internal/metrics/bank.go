@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+
+	bankgrpc "github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/bank"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Committer runs fn once the unit of work of ctx commits, or right away
+// outside of one.
+type Committer interface {
+	AfterCommit(ctx context.Context, fn func())
+}
+
+// Bank counts the money movements made through the wrapped service. Movements
+// are counted once committed, calls run in a unit of work that is rolled back
+// or retried count nothing. Replayed steps of transfers add no volume.
+type Bank struct {
+	bankgrpc.Bank
+	metrics   *Metrics
+	committer Committer
+}
+
+func InstrumentBank(bank bankgrpc.Bank, metrics *Metrics, committer Committer) *Bank {
+	return &Bank{
+		Bank:      bank,
+		metrics:   metrics,
+		committer: committer,
+	}
+}
+
+func (b *Bank) Deposit(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	moved, err := b.Bank.Deposit(ctx, details)
+	b.count(ctx, b.metrics.deposits, "deposit", details.Amount, moved, err)
+	return moved, err
+}
+
+func (b *Bank) Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	moved, err := b.Bank.Withdraw(ctx, details)
+	b.count(ctx, b.metrics.withdrawals, "withdraw", details.Amount, moved, err)
+	return moved, err
+}
+
+func (b *Bank) Refund(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	moved, err := b.Bank.Refund(ctx, details)
+	b.count(ctx, b.metrics.refunds, "refund", details.Amount, moved, err)
+	return moved, err
+}
+
+// count counts a failed call right away and a successful one once committed.
+func (b *Bank) count(ctx context.Context, calls *prometheus.CounterVec, operation string, amount int64, moved bool, err error) {
+	if err != nil {
+		calls.WithLabelValues(outcome(err)).Inc()
+		return
+	}
+
+	b.committer.AfterCommit(ctx, func() {
+		if !moved {
+			calls.WithLabelValues(OutcomeReplayed).Inc()
+			return
+		}
+		calls.WithLabelValues(OutcomeSuccess).Inc()
+		b.metrics.volume.WithLabelValues(operation).Add(float64(amount))
+	})
+}
+
+// BatchPostings counts every deposit and withdrawal of the batch, transfers
+// between accounts only add to the volume.
+func (b *Bank) BatchPostings(ctx context.Context, batch models.Batch) (models.BatchResult, error) {
+	result, err := b.Bank.BatchPostings(ctx, batch)
+	if err != nil {
+		return result, err
+	}
+
+	b.committer.AfterCommit(ctx, func() { b.countBatch(batch, result) })
+
+	return result, nil
+}
+
+func (b *Bank) countBatch(batch models.Batch, result models.BatchResult) {
+	for i, item := range batch.Items {
+		if i >= len(result.Items) {
+			break
+		}
+
+		o := outcome(result.Items[i].Err)
+		if result.Items[i].Status == models.BatchItemAborted {
+			o = OutcomeAborted
+		}
+
+		switch item.Type {
+		case models.BatchDeposit:
+			b.metrics.deposits.WithLabelValues(o).Inc()
+		case models.BatchWithdraw:
+			b.metrics.withdrawals.WithLabelValues(o).Inc()
+		}
+
+		if result.Items[i].Status == models.BatchItemApplied {
+			b.metrics.volume.WithLabelValues(string(item.Type)).Add(float64(item.Amount))
+		}
+	}
+}
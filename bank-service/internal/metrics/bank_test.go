@@ -0,0 +1,83 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/metrics"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicetest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestInstrumentBankCountsCommittedMovements(t *testing.T) {
+	ctx := context.Background()
+	f := servicetest.NewFixture(t)
+	reg := prometheus.NewRegistry()
+	b := metrics.InstrumentBank(f.Bank, metrics.New(reg), f.Store)
+
+	from, to := f.CreateAccount(t, 100), f.CreateAccount(t, 0)
+
+	// A deposit rolled back with its unit of work is not counted.
+	errAbort := errors.New("abort")
+	err := f.Store.Do(ctx, func(ctx context.Context) error {
+		if _, err := b.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: to, Amount: 50}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("Do: got %v, want %v", err, errAbort)
+	}
+	if got := value(t, reg, "bank_deposits_total", metrics.OutcomeSuccess); got != 0 {
+		t.Errorf("rolled back deposits: got %v, want 0", got)
+	}
+
+	// A replayed step of a transfer is counted without volume.
+	created := f.CreateTransfer(t, from, to, 30)
+	withdraw := models.TransactionDetails{TargetAccountUUID: from, Amount: 30, TransferUUID: created.UUID}
+	for i := range 2 {
+		if _, err := b.Withdraw(ctx, withdraw); err != nil {
+			t.Fatalf("Withdraw #%d: %v", i+1, err)
+		}
+	}
+
+	tests := []struct {
+		name, outcome string
+		want          float64
+	}{
+		{"bank_withdrawals_total", metrics.OutcomeSuccess, 1},
+		{"bank_withdrawals_total", metrics.OutcomeReplayed, 1},
+		{"bank_volume_moved_total", "withdraw", 30},
+		{"bank_volume_moved_total", "deposit", 0},
+	}
+	for _, tt := range tests {
+		if got := value(t, reg, tt.name, tt.outcome); got != tt.want {
+			t.Errorf("%s{%s}: got %v, want %v", tt.name, tt.outcome, got, tt.want)
+		}
+	}
+}
+
+// value sums the counters of the metric with the label value, a missing one counts zero.
+func value(t *testing.T, reg *prometheus.Registry, name, label string) float64 {
+	t.Helper()
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+
+	var sum float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			if m.GetLabel()[0].GetValue() == label {
+				sum += m.GetCounter().GetValue()
+			}
+		}
+	}
+	return sum
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"errors"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const _namespace = "bank"
+
+// Outcomes of money movements.
+const (
+	OutcomeSuccess           = "success"
+	OutcomeInsufficientFunds = "insufficient_funds"
+	OutcomeNotFound          = "not_found"
+	OutcomeInvalid           = "invalid"
+	OutcomeAborted           = "aborted"
+	OutcomeReplayed          = "replayed"
+	OutcomeError             = "error"
+)
+
+// Metrics are the business counters of the bank.
+type Metrics struct {
+	deposits    *prometheus.CounterVec
+	withdrawals *prometheus.CounterVec
+	refunds     *prometheus.CounterVec
+	volume      *prometheus.CounterVec
+}
+
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		deposits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: _namespace,
+			Name:      "deposits_total",
+			Help:      "Deposits by outcome, including the ones of batches.",
+		}, []string{"outcome"}),
+		withdrawals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: _namespace,
+			Name:      "withdrawals_total",
+			Help:      "Withdrawals by outcome, including the ones of batches.",
+		}, []string{"outcome"}),
+		refunds: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: _namespace,
+			Name:      "refunds_total",
+			Help:      "Refunds by outcome.",
+		}, []string{"outcome"}),
+		volume: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: _namespace,
+			Name:      "volume_moved_total",
+			Help:      "Amount of money moved by successful operations, in minor units.",
+		}, []string{"operation"}),
+	}
+
+	reg.MustRegister(m.deposits, m.withdrawals, m.refunds, m.volume)
+
+	return m
+}
+
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, servicerr.ErrInsufficientFunds):
+		return OutcomeInsufficientFunds
+	case errors.Is(err, servicerr.ErrNotFound):
+		return OutcomeNotFound
+	case errors.Is(err, servicerr.ErrInvalidArgument),
+		errors.Is(err, servicerr.ErrIllegalTransition),
+		errors.Is(err, servicerr.ErrTransferMismatch):
+		return OutcomeInvalid
+	default:
+		return OutcomeError
+	}
+}
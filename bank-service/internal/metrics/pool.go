@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	poolMaxConns = prometheus.NewDesc(
+		"pgxpool_max_conns", "Maximum size of the pool.", nil, nil)
+	poolTotalConns = prometheus.NewDesc(
+		"pgxpool_total_conns", "Connections currently in the pool.", nil, nil)
+	poolAcquiredConns = prometheus.NewDesc(
+		"pgxpool_acquired_conns", "Connections currently acquired.", nil, nil)
+	poolIdleConns = prometheus.NewDesc(
+		"pgxpool_idle_conns", "Connections currently idle.", nil, nil)
+	poolConstructingConns = prometheus.NewDesc(
+		"pgxpool_constructing_conns", "Connections currently being established.", nil, nil)
+	poolAcquires = prometheus.NewDesc(
+		"pgxpool_acquires_total", "Successful acquires from the pool.", nil, nil)
+	poolCanceledAcquires = prometheus.NewDesc(
+		"pgxpool_canceled_acquires_total", "Acquires canceled by their context.", nil, nil)
+	poolEmptyAcquires = prometheus.NewDesc(
+		"pgxpool_empty_acquires_total", "Acquires that had to wait for a connection.", nil, nil)
+	poolAcquireDuration = prometheus.NewDesc(
+		"pgxpool_acquire_duration_seconds_total", "Time spent acquiring connections.", nil, nil)
+	poolNewConns = prometheus.NewDesc(
+		"pgxpool_new_conns_total", "Connections opened.", nil, nil)
+	poolMaxLifetimeDestroys = prometheus.NewDesc(
+		"pgxpool_max_lifetime_destroys_total", "Connections closed for exceeding their lifetime.", nil, nil)
+	poolMaxIdleDestroys = prometheus.NewDesc(
+		"pgxpool_max_idle_destroys_total", "Connections closed for being idle for too long.", nil, nil)
+)
+
+// PoolCollector exports the statistics of a pgx pool, read at every scrape.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+}
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{pool: pool}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+
+	gauge := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v)
+	}
+	counter := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, v)
+	}
+
+	gauge(poolMaxConns, float64(s.MaxConns()))
+	gauge(poolTotalConns, float64(s.TotalConns()))
+	gauge(poolAcquiredConns, float64(s.AcquiredConns()))
+	gauge(poolIdleConns, float64(s.IdleConns()))
+	gauge(poolConstructingConns, float64(s.ConstructingConns()))
+	counter(poolAcquires, float64(s.AcquireCount()))
+	counter(poolCanceledAcquires, float64(s.CanceledAcquireCount()))
+	counter(poolEmptyAcquires, float64(s.EmptyAcquireCount()))
+	counter(poolAcquireDuration, s.AcquireDuration().Seconds())
+	counter(poolNewConns, float64(s.NewConnsCount()))
+	counter(poolMaxLifetimeDestroys, float64(s.MaxLifetimeDestroyCount()))
+	counter(poolMaxIdleDestroys, float64(s.MaxIdleDestroyCount()))
+}
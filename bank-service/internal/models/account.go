@@ -7,9 +7,11 @@ import (
 )
 
 type Account struct {
-	UUID      uuid.UUID  `db:"uuid"`
-	Name      string     `db:"account_name"`
-	Balance   int64      `db:"balance"`
+	UUID    uuid.UUID `db:"uuid"`
+	Name    string    `db:"account_name"`
+	Balance int64     `db:"balance"`
+	// Owner is the subject of the customer the account belongs to.
+	Owner     string     `db:"owner"`
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt *time.Time `db:"updated_at"`
 }
@@ -0,0 +1,19 @@
+package models
+
+import "github.com/google/uuid"
+
+// AccountImport is an account migrated from an external system.
+// ExternalID identifies it there and makes repeated imports skip it.
+type AccountImport struct {
+	ExternalID string
+	Name       string
+	Balance    int64
+	Owner      string
+}
+
+type AccountImportResult struct {
+	ExternalID  string
+	AccountUUID uuid.UUID
+	// Skipped is set if the account had been imported before.
+	Skipped bool
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// AuditEntry records a state-changing call. Entries form a hash chain:
+// each one hashes its own fields together with the hash of the previous one,
+// so altering or removing an entry breaks every hash after it.
+type AuditEntry struct {
+	ID      int64  `db:"id"`
+	Subject string `db:"subject"`
+	Method  string `db:"method"`
+	// Request and Response are JSON, Response is nil if the call failed.
+	Request   string    `db:"request"`
+	Code      string    `db:"code"`
+	Error     string    `db:"error"`
+	Response  *string   `db:"response"`
+	CreatedAt time.Time `db:"created_at"`
+	PrevHash  []byte    `db:"prev_hash"`
+	Hash      []byte    `db:"hash"`
+}
+
+// ChainHash computes the hash of the entry following prev. The fields are
+// length-prefixed, so moving bytes from one field to another changes the hash.
+func (e AuditEntry) ChainHash(prev []byte) []byte {
+	h := sha256.New()
+
+	write := func(b []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(b)))
+		h.Write(b)
+	}
+
+	write(prev)
+	write([]byte(e.Subject))
+	write([]byte(e.Method))
+	write([]byte(e.Request))
+	write([]byte(e.Code))
+	write([]byte(e.Error))
+	if e.Response != nil {
+		write([]byte(*e.Response))
+	} else {
+		// Distinguishes a missing response from an empty one.
+		_ = binary.Write(h, binary.BigEndian, uint64(1<<63))
+	}
+	_ = binary.Write(h, binary.BigEndian, e.CreatedAt.UnixMicro())
+
+	return h.Sum(nil)
+}
+
+type AuditFilter struct {
+	Subject string
+	Method  string
+	From    *time.Time
+	To      *time.Time
+	Limit   int
+	// AfterID continues a listing past the entry with the given id.
+	AfterID int64
+}
+
+// AuditVerification is the result of checking the whole audit chain.
+type AuditVerification struct {
+	Valid   bool
+	Checked int64
+	// FirstInvalidID is the first entry breaking the chain, zero if it is valid.
+	FirstInvalidID int64
+	Reason         string
+}
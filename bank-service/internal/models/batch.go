@@ -0,0 +1,59 @@
+package models
+
+import "github.com/google/uuid"
+
+// MaxBatchItems limits the size of a single batch.
+const MaxBatchItems = 10000
+
+type BatchMode string
+
+const (
+	// BatchAllOrNothing applies every item or none of them.
+	BatchAllOrNothing BatchMode = "all_or_nothing"
+	// BatchBestEffort applies every item that can be applied.
+	BatchBestEffort BatchMode = "best_effort"
+)
+
+type BatchItemType string
+
+const (
+	BatchDeposit  BatchItemType = "deposit"
+	BatchWithdraw BatchItemType = "withdraw"
+	BatchTransfer BatchItemType = "transfer"
+)
+
+type BatchItemStatus string
+
+const (
+	BatchItemApplied  BatchItemStatus = "applied"
+	BatchItemRejected BatchItemStatus = "rejected"
+	// BatchItemAborted marks an item that could be applied but was rolled back
+	// together with the rest of an all-or-nothing batch.
+	BatchItemAborted BatchItemStatus = "aborted"
+)
+
+// BatchItem moves money into ToAccountUUID for deposits, out of
+// FromAccountUUID for withdrawals and between both of them for transfers.
+type BatchItem struct {
+	Type            BatchItemType
+	FromAccountUUID uuid.UUID
+	ToAccountUUID   uuid.UUID
+	Amount          int64
+}
+
+type Batch struct {
+	UUID  uuid.UUID
+	Mode  BatchMode
+	Items []BatchItem
+}
+
+type BatchItemResult struct {
+	Status BatchItemStatus
+	Err    error
+}
+
+type BatchResult struct {
+	UUID      uuid.UUID
+	Committed bool
+	Items     []BatchItemResult
+}
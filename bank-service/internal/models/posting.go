@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostingKind string
+
+const (
+	PostingOpening  PostingKind = "opening"
+	PostingDeposit  PostingKind = "deposit"
+	PostingWithdraw PostingKind = "withdraw"
+	PostingRefund   PostingKind = "refund"
+	PostingTransfer PostingKind = "transfer"
+)
+
+// Posting is a ledger entry. Every change of an account balance is recorded
+// as a posting with a positive amount for credits and a negative one for debits.
+type Posting struct {
+	ID           int64       `db:"id"`
+	AccountUUID  uuid.UUID   `db:"account_uuid"`
+	Amount       int64       `db:"amount"`
+	Kind         PostingKind `db:"kind"`
+	TransferUUID *uuid.UUID  `db:"transfer_uuid"`
+	BatchUUID    *uuid.UUID  `db:"batch_uuid"`
+	CreatedAt    time.Time   `db:"created_at"`
+}
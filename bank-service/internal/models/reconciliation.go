@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	ReconciliationTrigger string
+	ReconciliationStatus  string
+)
+
+const (
+	ReconciliationManual    ReconciliationTrigger = "manual"
+	ReconciliationScheduled ReconciliationTrigger = "scheduled"
+
+	ReconciliationRunning   ReconciliationStatus = "running"
+	ReconciliationCompleted ReconciliationStatus = "completed"
+	ReconciliationFailed    ReconciliationStatus = "failed"
+)
+
+// BalanceMismatch is an account whose stored balance differs from the sum of its postings.
+type BalanceMismatch struct {
+	AccountUUID   uuid.UUID `json:"account_uuid"`
+	Balance       int64     `json:"balance"`
+	LedgerBalance int64     `json:"ledger_balance"`
+	// Repaired is set once the stored balance has been reset to the ledger one.
+	Repaired bool `json:"repaired"`
+}
+
+type ReconciliationRun struct {
+	UUID            uuid.UUID             `db:"uuid"`
+	Trigger         ReconciliationTrigger `db:"trigger"`
+	Repair          bool                  `db:"repair"`
+	Status          ReconciliationStatus  `db:"status"`
+	AccountsChecked int64                 `db:"accounts_checked"`
+	Mismatches      []BalanceMismatch     `db:"mismatches"`
+	Error           string                `db:"error"`
+	StartedAt       time.Time             `db:"started_at"`
+	FinishedAt      *time.Time            `db:"finished_at"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BalanceAt is the balance of an account including every posting made up to At.
+type BalanceAt struct {
+	AccountUUID uuid.UUID
+	At          time.Time
+	Balance     int64
+}
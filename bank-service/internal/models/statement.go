@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StatementFormat string
+
+const (
+	StatementCSV  StatementFormat = "csv"
+	StatementJSON StatementFormat = "json"
+)
+
+// Statement lists the postings of an account made within [From, To).
+type Statement struct {
+	AccountUUID    uuid.UUID
+	From           time.Time
+	To             time.Time
+	Format         StatementFormat
+	OpeningBalance int64
+	ClosingBalance int64
+	// LedgerBalance is the sum of all postings of the account,
+	// which must be equal to its stored Balance.
+	LedgerBalance int64
+	Balance       int64
+}
@@ -5,4 +5,6 @@ import "github.com/google/uuid"
 type TransactionDetails struct {
 	TargetAccountUUID uuid.UUID
 	Amount            int64
+	// TransferUUID links the movement to a transfer, uuid.Nil for standalone movements.
+	TransferUUID uuid.UUID
 }
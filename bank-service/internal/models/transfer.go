@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransferStatus string
+
+const (
+	TransferPending      TransferStatus = "pending"
+	TransferDebited      TransferStatus = "debited"
+	TransferCompleted    TransferStatus = "completed"
+	TransferCompensating TransferStatus = "compensating"
+	TransferFailed       TransferStatus = "failed"
+	TransferRefunded     TransferStatus = "refunded"
+)
+
+// transferTransitions is the transfer state machine. Statuses never repeat,
+// so every status is entered at most once during a transfer's life.
+var transferTransitions = map[TransferStatus][]TransferStatus{
+	TransferPending:      {TransferDebited, TransferFailed},
+	TransferDebited:      {TransferCompleted, TransferCompensating},
+	TransferCompensating: {TransferRefunded, TransferFailed},
+}
+
+func (s TransferStatus) Valid() bool {
+	switch s {
+	case TransferPending, TransferDebited, TransferCompleted,
+		TransferCompensating, TransferFailed, TransferRefunded:
+		return true
+	}
+	return false
+}
+
+func (s TransferStatus) CanTransitionTo(next TransferStatus) bool {
+	for _, allowed := range transferTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+type Transfer struct {
+	UUID            uuid.UUID      `db:"uuid"`
+	FromAccountUUID uuid.UUID      `db:"from_account_uuid"`
+	ToAccountUUID   uuid.UUID      `db:"to_account_uuid"`
+	Amount          int64          `db:"amount"`
+	Status          TransferStatus `db:"status"`
+	FailureReason   string         `db:"failure_reason"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       *time.Time     `db:"updated_at"`
+	Events          []TransferEvent
+}
+
+// TransferEvent records a single state transition of a transfer.
+type TransferEvent struct {
+	FromStatus *TransferStatus `db:"from_status"`
+	ToStatus   TransferStatus  `db:"to_status"`
+	Reason     string          `db:"reason"`
+	CreatedAt  time.Time       `db:"created_at"`
+}
+
+type TransferFilter struct {
+	AccountUUID uuid.UUID
+	Status      TransferStatus
+	Limit       int
+	// After continues a listing past the given transfer, ordered by creation time.
+	After *TransferCursor
+}
+
+type TransferCursor struct {
+	CreatedAt time.Time
+	UUID      uuid.UUID
+}
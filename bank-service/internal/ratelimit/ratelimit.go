@@ -0,0 +1,259 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	_retryAfterHeader = "retry-after"
+	// Network of the in-process connection of the gateway, and the header
+	// it appends the address of its HTTP client to.
+	_inProcessNetwork   = "bufconn"
+	_forwardedForHeader = "x-forwarded-for"
+	// Buckets of callers idle for longer than that are dropped.
+	_idleTTL = 10 * time.Minute
+)
+
+// Methods limited by the global concurrency limit.
+var moneyMoving = map[string]bool{
+	bankv1.Bank_Deposit_FullMethodName:       true,
+	bankv1.Bank_Withdraw_FullMethodName:      true,
+	bankv1.Bank_Refund_FullMethodName:        true,
+	bankv1.Bank_BatchPostings_FullMethodName: true,
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter keeps a token bucket per caller and method, and bounds the number
+// of money-moving calls running at once across all callers.
+type Limiter struct {
+	log     *slog.Logger
+	cfg     config.RateLimitConfig
+	methods map[string]config.LimitConfig
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+
+	// Slots of the running money-moving calls, nil without a limit.
+	slots chan struct{}
+}
+
+func New(log *slog.Logger, cfg config.RateLimitConfig) (*Limiter, error) {
+	const op = "ratelimit.New"
+
+	known := make(map[string]bool)
+	for _, m := range bankv1.Bank_ServiceDesc.Methods {
+		known[m.MethodName] = true
+	}
+	for _, s := range bankv1.Bank_ServiceDesc.Streams {
+		known[s.StreamName] = true
+	}
+
+	methods := make(map[string]config.LimitConfig, len(cfg.Methods))
+	for name, limit := range cfg.Methods {
+		if !known[name] {
+			return nil, fmt.Errorf("%s: unknown method %q", op, name)
+		}
+		fullMethod := "/" + bankv1.Bank_ServiceDesc.ServiceName + "/" + name
+		methods[fullMethod] = limit
+	}
+
+	l := &Limiter{
+		log:     log,
+		cfg:     cfg,
+		methods: methods,
+		buckets: make(map[string]*bucket),
+		swept:   time.Now(),
+	}
+
+	if cfg.MaxConcurrentMoneyMoves < 0 {
+		return nil, fmt.Errorf("%s: negative concurrency limit", op)
+	}
+	if cfg.MaxConcurrentMoneyMoves > 0 {
+		l.slots = make(chan struct{}, cfg.MaxConcurrentMoneyMoves)
+	}
+
+	return l, nil
+}
+
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := l.admit(ctx, info.FullMethod, func(md metadata.MD) error {
+			return grpc.SetTrailer(ctx, md)
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := l.admit(ss.Context(), info.FullMethod, func(md metadata.MD) error {
+			ss.SetTrailer(md)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+// admit takes a token of the caller bucket and a concurrency slot for money-moving
+// methods. The returned function releases the slot once the call is done.
+func (l *Limiter) admit(ctx context.Context, fullMethod string, setTrailer func(metadata.MD) error) (func(), error) {
+	const op = "Limiter.admit"
+
+	caller := callerKey(ctx)
+
+	if delay := l.reserve(caller, fullMethod); delay > 0 {
+		l.log.Warn("rate limited",
+			slog.String("op", op),
+			slog.String("caller", caller),
+			slog.String("method", fullMethod),
+		)
+		return nil, exhausted(setTrailer, grpcerr.ReasonRateLimited, "rate limit exceeded", delay)
+	}
+
+	if l.slots == nil || !moneyMoving[fullMethod] {
+		return func() {}, nil
+	}
+
+	timer := time.NewTimer(l.cfg.MoneyMoveWait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-timer.C:
+		l.log.Warn("too many concurrent money moves",
+			slog.String("op", op),
+			slog.String("caller", caller),
+			slog.String("method", fullMethod),
+		)
+		return nil, exhausted(setTrailer, grpcerr.ReasonTooManyMoneyMoves, "too many concurrent money moves", l.cfg.MoneyMoveWait)
+	case <-ctx.Done():
+		return nil, grpcerr.FromService(ctx.Err())
+	}
+}
+
+// reserve takes a token of the bucket and returns how long the caller
+// has to wait for it, in which case the token is given back.
+func (l *Limiter) reserve(caller, fullMethod string) time.Duration {
+	limit := l.limitFor(caller, fullMethod)
+	if limit.Rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	key := caller + " " + fullMethod
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.swept) > _idleTTL {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > _idleTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.swept = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.Rate))
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return time.Second
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay
+	}
+
+	return 0
+}
+
+// limitFor picks the quota of the caller, then the limit of the method and then the default one.
+func (l *Limiter) limitFor(caller, fullMethod string) config.LimitConfig {
+	if limit, ok := l.cfg.Clients[caller]; ok {
+		return limit
+	}
+	if limit, ok := l.methods[fullMethod]; ok {
+		return limit
+	}
+	return l.cfg.Default
+}
+
+// callerKey identifies the caller by its authenticated subject
+// or, if authentication is disabled, by its address. Calls of the in-process
+// gateway are made on behalf of the HTTP client it forwards the address of.
+func callerKey(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr.Network() == _inProcessNetwork {
+			return forwardedFor(ctx)
+		}
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// forwardedFor returns the last X-Forwarded-For address, the one the gateway
+// has seen. The ones before it are sent by the client and can not be trusted.
+func forwardedFor(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, _forwardedForHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	addrs := strings.Split(values[len(values)-1], ",")
+	return strings.TrimSpace(addrs[len(addrs)-1])
+}
+
+func exhausted(setTrailer func(metadata.MD) error, reason, msg string, delay time.Duration) error {
+	seconds := int64(math.Ceil(delay.Seconds()))
+	_ = setTrailer(metadata.Pairs(_retryAfterHeader, strconv.FormatInt(seconds, 10)))
+
+	return grpcerr.ResourceExhausted(reason, msg, delay)
+}
@@ -0,0 +1,324 @@
+package ratelimit_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/auth"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/config"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/controller/grpc/grpcerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/ratelimit"
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// A rate this slow refills no token while a test runs, the burst alone is admitted.
+const _slow = 0.001
+
+func newLimiter(t *testing.T, cfg config.RateLimitConfig) *ratelimit.Limiter {
+	t.Helper()
+
+	l, err := ratelimit.New(slog.New(slog.NewTextHandler(io.Discard, nil)), cfg)
+	if err != nil {
+		t.Fatalf("ratelimit.New: %v", err)
+	}
+	return l
+}
+
+func caller(subject string) context.Context {
+	return auth.WithIdentity(context.Background(), auth.Identity{Subject: subject})
+}
+
+func call(ctx context.Context, interceptor grpc.UnaryServerInterceptor, fullMethod string) error {
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod},
+		func(ctx context.Context, req any) (any, error) { return nil, nil })
+	return err
+}
+
+// admitted makes n calls and counts the ones let through, the others must be rate limited.
+func admitted(t *testing.T, ctx context.Context, interceptor grpc.UnaryServerInterceptor, fullMethod string, n int) int {
+	t.Helper()
+
+	var ok int
+	for range n {
+		err := call(ctx, interceptor, fullMethod)
+		if err == nil {
+			ok++
+			continue
+		}
+		if got := grpcerr.Reason(err); got != grpcerr.ReasonRateLimited {
+			t.Fatalf("reason: got %q (%v), want %q", got, err, grpcerr.ReasonRateLimited)
+		}
+		if got := status.Code(err); got != codes.ResourceExhausted {
+			t.Fatalf("code: got %v, want %v", got, codes.ResourceExhausted)
+		}
+	}
+	return ok
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.RateLimitConfig
+		wantErr bool
+	}{
+		{name: "empty"},
+		{name: "known method", cfg: config.RateLimitConfig{Methods: map[string]config.LimitConfig{"Deposit": {Rate: 1}}}},
+		{name: "unknown method", cfg: config.RateLimitConfig{Methods: map[string]config.LimitConfig{"Steal": {Rate: 1}}}, wantErr: true},
+		{name: "negative concurrency", cfg: config.RateLimitConfig{MaxConcurrentMoneyMoves: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ratelimit.New(slog.New(slog.NewTextHandler(io.Discard, nil)), tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New: got %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.RateLimitConfig
+		caller string
+		method string
+		want   int
+	}{
+		{
+			name:   "no limit",
+			caller: "alice",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   10,
+		},
+		{
+			name:   "default",
+			cfg:    config.RateLimitConfig{Default: config.LimitConfig{Rate: _slow, Burst: 3}},
+			caller: "alice",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   3,
+		},
+		{
+			name:   "burst defaults to the rate rounded up",
+			cfg:    config.RateLimitConfig{Default: config.LimitConfig{Rate: 1.5}},
+			caller: "alice",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   2,
+		},
+		{
+			name: "method limit over the default",
+			cfg: config.RateLimitConfig{
+				Default: config.LimitConfig{Rate: _slow, Burst: 3},
+				Methods: map[string]config.LimitConfig{"Deposit": {Rate: _slow, Burst: 1}},
+			},
+			caller: "alice",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   1,
+		},
+		{
+			name: "default for other methods",
+			cfg: config.RateLimitConfig{
+				Default: config.LimitConfig{Rate: _slow, Burst: 3},
+				Methods: map[string]config.LimitConfig{"Deposit": {Rate: _slow, Burst: 1}},
+			},
+			caller: "alice",
+			method: bankv1.Bank_GetAccount_FullMethodName,
+			want:   3,
+		},
+		{
+			name: "client quota over the method limit",
+			cfg: config.RateLimitConfig{
+				Methods: map[string]config.LimitConfig{"Deposit": {Rate: _slow, Burst: 1}},
+				Clients: map[string]config.LimitConfig{"worker": {Rate: _slow, Burst: 5}},
+			},
+			caller: "worker",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   5,
+		},
+		{
+			name: "client without limit",
+			cfg: config.RateLimitConfig{
+				Default: config.LimitConfig{Rate: _slow, Burst: 1},
+				Clients: map[string]config.LimitConfig{"worker": {}},
+			},
+			caller: "worker",
+			method: bankv1.Bank_Deposit_FullMethodName,
+			want:   10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := newLimiter(t, tt.cfg).UnaryServerInterceptor()
+			if got := admitted(t, caller(tt.caller), interceptor, tt.method, 10); got != tt.want {
+				t.Errorf("admitted: got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// gatewayAddr is the address of the in-process connection of the gateway.
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return "bufconn" }
+func (gatewayAddr) String() string  { return "bufconn" }
+
+func fromPeer(addr net.Addr, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	md := metadata.MD{}
+	for _, v := range forwardedFor {
+		md.Append("x-forwarded-for", v)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestBucketPerCaller(t *testing.T) {
+	tcp := func(ip string, port int) net.Addr { return &net.TCPAddr{IP: net.ParseIP(ip), Port: port} }
+
+	tests := []struct {
+		name          string
+		first, second context.Context
+		// shared tells whether both calls draw from the same bucket.
+		shared bool
+	}{
+		{
+			name:   "subjects",
+			first:  caller("alice"),
+			second: caller("bob"),
+		},
+		{
+			name:   "addresses",
+			first:  fromPeer(tcp("10.0.0.1", 5000)),
+			second: fromPeer(tcp("10.0.0.2", 5000)),
+		},
+		{
+			name:   "ports of one address",
+			first:  fromPeer(tcp("10.0.0.1", 5000)),
+			second: fromPeer(tcp("10.0.0.1", 5001)),
+			shared: true,
+		},
+		{
+			name:   "gateway clients",
+			first:  fromPeer(gatewayAddr{}, "10.0.0.1"),
+			second: fromPeer(gatewayAddr{}, "10.0.0.2"),
+		},
+		{
+			name:   "gateway client spoofing the forwarded address",
+			first:  fromPeer(gatewayAddr{}, "10.0.0.1"),
+			second: fromPeer(gatewayAddr{}, "10.0.0.9, 10.0.0.1"),
+			shared: true,
+		},
+		{
+			name:   "forwarded address of a direct caller",
+			first:  fromPeer(tcp("10.0.0.1", 5000), "10.0.0.2"),
+			second: fromPeer(tcp("10.0.0.1", 5000), "10.0.0.3"),
+			shared: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := newLimiter(t, config.RateLimitConfig{
+				Default: config.LimitConfig{Rate: _slow, Burst: 1},
+			}).UnaryServerInterceptor()
+
+			if err := call(tt.first, interceptor, bankv1.Bank_GetAccount_FullMethodName); err != nil {
+				t.Fatalf("first call: %v", err)
+			}
+			err := call(tt.second, interceptor, bankv1.Bank_GetAccount_FullMethodName)
+			if shared := err != nil; shared != tt.shared {
+				t.Errorf("second call: got %v, want shared bucket %v", err, tt.shared)
+			}
+		})
+	}
+}
+
+func TestMoneyMoveSlots(t *testing.T) {
+	interceptor := newLimiter(t, config.RateLimitConfig{
+		MaxConcurrentMoneyMoves: 1,
+		MoneyMoveWait:           20 * time.Millisecond,
+	}).UnaryServerInterceptor()
+
+	// The first deposit holds the only slot until the test lets it go.
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := interceptor(caller("alice"), nil, &grpc.UnaryServerInfo{FullMethod: bankv1.Bank_Deposit_FullMethodName},
+			func(ctx context.Context, req any) (any, error) {
+				close(started)
+				<-release
+				return nil, nil
+			})
+		done <- err
+	}()
+	<-started
+
+	cancelled, cancel := context.WithCancel(caller("bob"))
+	cancel()
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+		reason string
+	}{
+		{
+			name:   "money move waits for a slot",
+			ctx:    caller("bob"),
+			method: bankv1.Bank_Withdraw_FullMethodName,
+			code:   codes.ResourceExhausted,
+			reason: grpcerr.ReasonTooManyMoneyMoves,
+		},
+		{
+			name:   "batch is a money move",
+			ctx:    caller("bob"),
+			method: bankv1.Bank_BatchPostings_FullMethodName,
+			code:   codes.ResourceExhausted,
+			reason: grpcerr.ReasonTooManyMoneyMoves,
+		},
+		{
+			name:   "caller gone while waiting",
+			ctx:    cancelled,
+			method: bankv1.Bank_Deposit_FullMethodName,
+			code:   codes.Canceled,
+		},
+		{
+			name:   "other methods take no slot",
+			ctx:    caller("bob"),
+			method: bankv1.Bank_GetAccount_FullMethodName,
+			code:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := call(tt.ctx, interceptor, tt.method)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code: got %v (%v), want %v", got, err, tt.code)
+			}
+			if got := grpcerr.Reason(err); tt.reason != "" && got != tt.reason {
+				t.Errorf("reason: got %q, want %q", got, tt.reason)
+			}
+		})
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first deposit: %v", err)
+	}
+
+	// The slot is free again once the first deposit is done.
+	if err := call(caller("bob"), interceptor, bankv1.Bank_Deposit_FullMethodName); err != nil {
+		t.Errorf("deposit after release: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package memdb
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+)
+
+type AuditRepo struct {
+	*Store
+}
+
+func NewAuditRepo(store *Store) *AuditRepo {
+	return &AuditRepo{store}
+}
+
+// AppendAudit chains the entry to the last one and appends it. Appends hold
+// the write lock, so two entries never share a predecessor.
+func (a *AuditRepo) AppendAudit(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	defer a.lock(ctx)()
+
+	prev := []byte{}
+	if len(a.audit) > 0 {
+		prev = a.audit[len(a.audit)-1].Hash
+	}
+
+	entry.ID = int64(len(a.audit)) + 1
+	entry.PrevHash = prev
+	entry.Hash = entry.ChainHash(prev)
+	a.audit = append(a.audit, entry)
+	a.onRollback(func() { a.audit = a.audit[:len(a.audit)-1] })
+
+	return entry, nil
+}
+
+func (a *AuditRepo) ListAudit(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	defer a.rlock(ctx)()
+
+	var entries []models.AuditEntry
+	for _, entry := range a.audit {
+		if len(entries) >= filter.Limit {
+			break
+		}
+
+		switch {
+		case entry.ID <= filter.AfterID,
+			filter.Subject != "" && entry.Subject != filter.Subject,
+			filter.Method != "" && entry.Method != filter.Method,
+			filter.From != nil && entry.CreatedAt.Before(*filter.From),
+			filter.To != nil && !entry.CreatedAt.Before(*filter.To):
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ScanAudit calls fn for every entry in chain order. The log is only ever
+// appended to, so the entries read under the lock are passed on without it.
+func (a *AuditRepo) ScanAudit(ctx context.Context, fn func(models.AuditEntry) error) error {
+	unlock := a.rlock(ctx)
+	entries := a.audit
+	unlock()
+
+	for _, entry := range entries {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package memdb
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+type BankRepo struct {
+	*Store
+}
+
+func New(store *Store) *BankRepo {
+	return &BankRepo{store}
+}
+
+func (b *BankRepo) CreateAccount(ctx context.Context, account models.Account) (uuid.UUID, error) {
+	defer b.lock(ctx)()
+
+	return b.createAccount(account, ""), nil
+}
+
+// createAccount stores a new account together with its opening posting.
+// Must be called with the write lock held.
+func (s *Store) createAccount(account models.Account, externalID string) uuid.UUID {
+	account.UUID = uuid.New()
+	account.CreatedAt = now()
+	account.UpdatedAt = nil
+
+	s.accounts[account.UUID] = &accountRecord{Account: account, externalID: externalID}
+	if externalID != "" {
+		s.externalIDs[externalID] = account.UUID
+	}
+	s.onRollback(func() {
+		delete(s.accounts, account.UUID)
+		if externalID != "" {
+			delete(s.externalIDs, externalID)
+		}
+	})
+
+	if account.Balance != 0 {
+		s.record(models.Posting{
+			AccountUUID: account.UUID,
+			Amount:      account.Balance,
+			Kind:        models.PostingOpening,
+			CreatedAt:   account.CreatedAt,
+		})
+	}
+
+	return account.UUID
+}
+
+func (b *BankRepo) GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Account, error) {
+	defer b.rlock(ctx)()
+
+	acc, ok := b.accounts[accountUUID]
+	if !ok {
+		return models.Account{}, repoerr.ErrNotFound
+	}
+
+	return acc.Account, nil
+}
+
+// DeleteAccount removes the account only, its postings stay in the ledger
+// as they do in Postgres.
+func (b *BankRepo) DeleteAccount(ctx context.Context, accountUUID uuid.UUID) error {
+	defer b.lock(ctx)()
+
+	acc, ok := b.accounts[accountUUID]
+	if !ok {
+		return repoerr.ErrNotFound
+	}
+
+	delete(b.accounts, accountUUID)
+	if acc.externalID != "" {
+		delete(b.externalIDs, acc.externalID)
+	}
+	b.onRollback(func() {
+		b.accounts[accountUUID] = acc
+		if acc.externalID != "" {
+			b.externalIDs[acc.externalID] = accountUUID
+		}
+	})
+
+	return nil
+}
+
+func (b *BankRepo) Deposit(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferCompleted)
+	}
+
+	defer b.lock(ctx)()
+
+	if err := b.post(models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      details.Amount,
+		Kind:        models.PostingDeposit,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BankRepo) Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferDebited)
+	}
+
+	defer b.lock(ctx)()
+
+	if err := b.post(models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      -details.Amount,
+		Kind:        models.PostingWithdraw,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BankRepo) Refund(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferRefunded)
+	}
+
+	defer b.lock(ctx)()
+
+	if err := b.post(models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      details.Amount,
+		Kind:        models.PostingRefund,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// transferStep moves the money of a transfer step and advances the transfer
+// to the given status at once. A step that has already been applied is
+// acknowledged without moving the money again, it reports whether the money moved.
+func (b *BankRepo) transferStep(ctx context.Context, details models.TransactionDetails, to models.TransferStatus) (bool, error) {
+	defer b.lock(ctx)()
+
+	transfer, ok := b.transfers[details.TransferUUID]
+	if !ok {
+		return false, repoerr.ErrNotFound
+	}
+
+	transferUUID := transfer.UUID
+	posting := models.Posting{
+		AccountUUID:  transfer.FromAccountUUID,
+		Amount:       transfer.Amount,
+		Kind:         models.PostingRefund,
+		TransferUUID: &transferUUID,
+	}
+	switch to {
+	case models.TransferDebited:
+		posting.Amount, posting.Kind = -transfer.Amount, models.PostingWithdraw
+	case models.TransferCompleted:
+		posting.AccountUUID, posting.Kind = transfer.ToAccountUUID, models.PostingDeposit
+	}
+
+	if details.TargetAccountUUID != posting.AccountUUID || details.Amount != transfer.Amount {
+		return false, repoerr.ErrTransferMismatch
+	}
+
+	if reached(transfer, to) {
+		return false, nil
+	}
+	if !transfer.Status.CanTransitionTo(to) {
+		return false, repoerr.ErrIllegalTransition
+	}
+
+	// Nothing is changed unless both the posting and the transition succeed.
+	if err := b.post(posting); err != nil {
+		return false, err
+	}
+	b.transitionTransfer(transfer, to, "")
+
+	return true, nil
+}
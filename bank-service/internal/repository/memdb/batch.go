@@ -0,0 +1,117 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+// ApplyBatch applies the items to staged balances first and only writes them
+// to the store once the batch is known to commit. An all-or-nothing batch is
+// dropped if any item is rejected, a best-effort batch commits the items that
+// were applied.
+func (b *BankRepo) ApplyBatch(ctx context.Context, batch models.Batch) (models.BatchResult, error) {
+	const op = "BankRepo.ApplyBatch"
+
+	result := models.BatchResult{
+		UUID:  batch.UUID,
+		Items: make([]models.BatchItemResult, len(batch.Items)),
+	}
+
+	defer b.lock(ctx)()
+
+	var (
+		batchUUID = batch.UUID
+		balances  = make(map[uuid.UUID]int64)
+		postings  []models.Posting
+		rejected  bool
+	)
+
+	balance := func(accountUUID uuid.UUID) (int64, bool) {
+		if staged, ok := balances[accountUUID]; ok {
+			return staged, true
+		}
+		acc, ok := b.accounts[accountUUID]
+		if !ok {
+			return 0, false
+		}
+		return acc.Balance, true
+	}
+
+	move := func(accountUUID uuid.UUID, amount int64, kind models.PostingKind) {
+		current, _ := balance(accountUUID)
+		balances[accountUUID] = current + amount
+		postings = append(postings, models.Posting{
+			AccountUUID: accountUUID,
+			Amount:      amount,
+			Kind:        kind,
+			BatchUUID:   &batchUUID,
+		})
+	}
+
+	for i, item := range batch.Items {
+		var err error
+
+		switch item.Type {
+		case models.BatchDeposit:
+			if _, ok := balance(item.ToAccountUUID); !ok {
+				err = repoerr.ErrNotFound
+				break
+			}
+			move(item.ToAccountUUID, item.Amount, models.PostingDeposit)
+		case models.BatchWithdraw:
+			source, ok := balance(item.FromAccountUUID)
+			switch {
+			case !ok:
+				err = repoerr.ErrNotFound
+			case source < item.Amount:
+				err = repoerr.ErrInsufficientFunds
+			default:
+				move(item.FromAccountUUID, -item.Amount, models.PostingWithdraw)
+			}
+		case models.BatchTransfer:
+			source, sourceFound := balance(item.FromAccountUUID)
+			_, targetFound := balance(item.ToAccountUUID)
+			switch {
+			case !sourceFound || !targetFound:
+				err = repoerr.ErrNotFound
+			case source < item.Amount:
+				err = repoerr.ErrInsufficientFunds
+			default:
+				move(item.FromAccountUUID, -item.Amount, models.PostingTransfer)
+				move(item.ToAccountUUID, item.Amount, models.PostingTransfer)
+			}
+		default:
+			return models.BatchResult{}, fmt.Errorf("%s: unknown item type %q", op, item.Type)
+		}
+
+		if err != nil {
+			result.Items[i] = models.BatchItemResult{Status: models.BatchItemRejected, Err: err}
+			rejected = true
+			continue
+		}
+		result.Items[i] = models.BatchItemResult{Status: models.BatchItemApplied}
+	}
+
+	if rejected && batch.Mode == models.BatchAllOrNothing {
+		for i := range result.Items {
+			if result.Items[i].Status == models.BatchItemApplied {
+				result.Items[i].Status = models.BatchItemAborted
+			}
+		}
+		return result, nil
+	}
+
+	for accountUUID, staged := range balances {
+		b.setBalance(accountUUID, staged)
+	}
+	for _, posting := range postings {
+		b.record(posting)
+	}
+	result.Committed = true
+
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package memdb
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// ImportAccounts inserts the accounts whose external id is not known yet,
+// together with their opening postings. It returns the uuids of the inserted
+// accounts by external id.
+func (b *BankRepo) ImportAccounts(ctx context.Context, accounts []models.AccountImport) (map[string]uuid.UUID, error) {
+	defer b.lock(ctx)()
+
+	imported := make(map[string]uuid.UUID, len(accounts))
+	for _, account := range accounts {
+		if _, ok := b.externalIDs[account.ExternalID]; ok {
+			continue
+		}
+
+		imported[account.ExternalID] = b.createAccount(models.Account{
+			Name:    account.Name,
+			Balance: account.Balance,
+			Owner:   account.Owner,
+		}, account.ExternalID)
+	}
+
+	return imported, nil
+}
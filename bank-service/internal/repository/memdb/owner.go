@@ -0,0 +1,19 @@
+package memdb
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+func (b *BankRepo) AccountOwner(ctx context.Context, accountUUID uuid.UUID) (string, error) {
+	defer b.rlock(ctx)()
+
+	acc, ok := b.accounts[accountUUID]
+	if !ok {
+		return "", repoerr.ErrNotFound
+	}
+
+	return acc.Owner, nil
+}
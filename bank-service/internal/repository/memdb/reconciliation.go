@@ -0,0 +1,119 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+type ReconciliationRepo struct {
+	*Store
+}
+
+func NewReconciliationRepo(store *Store) *ReconciliationRepo {
+	return &ReconciliationRepo{store}
+}
+
+func (r *ReconciliationRepo) CreateRun(ctx context.Context, run models.ReconciliationRun) (models.ReconciliationRun, error) {
+	defer r.lock(ctx)()
+
+	created := &models.ReconciliationRun{
+		UUID:       uuid.New(),
+		Trigger:    run.Trigger,
+		Repair:     run.Repair,
+		Status:     models.ReconciliationRunning,
+		Mismatches: []models.BalanceMismatch{},
+		StartedAt:  now(),
+	}
+	r.runs[created.UUID] = created
+	r.onRollback(func() { delete(r.runs, created.UUID) })
+
+	return cloneRun(created), nil
+}
+
+func (r *ReconciliationRepo) FinishRun(ctx context.Context, run models.ReconciliationRun) error {
+	defer r.lock(ctx)()
+
+	stored, ok := r.runs[run.UUID]
+	if !ok {
+		return nil
+	}
+
+	prev := *stored
+	r.onRollback(func() { *stored = prev })
+
+	finishedAt := now()
+	stored.Status = run.Status
+	stored.AccountsChecked = run.AccountsChecked
+	stored.Mismatches = slices.Clone(run.Mismatches)
+	if stored.Mismatches == nil {
+		stored.Mismatches = []models.BalanceMismatch{}
+	}
+	stored.Error = run.Error
+	stored.FinishedAt = &finishedAt
+
+	return nil
+}
+
+func (r *ReconciliationRepo) GetRun(ctx context.Context, runUUID uuid.UUID) (models.ReconciliationRun, error) {
+	defer r.rlock(ctx)()
+
+	run, ok := r.runs[runUUID]
+	if !ok {
+		return models.ReconciliationRun{}, repoerr.ErrNotFound
+	}
+
+	return cloneRun(run), nil
+}
+
+// FindMismatches compares every account balance with the sum of its postings.
+func (r *ReconciliationRepo) FindMismatches(ctx context.Context) ([]models.BalanceMismatch, int64, error) {
+	defer r.rlock(ctx)()
+
+	var mismatches []models.BalanceMismatch
+	for accountUUID, acc := range r.accounts {
+		if ledger := r.ledgerBalance(accountUUID, time.Time{}); ledger != acc.Balance {
+			mismatches = append(mismatches, models.BalanceMismatch{
+				AccountUUID:   accountUUID,
+				Balance:       acc.Balance,
+				LedgerBalance: ledger,
+			})
+		}
+	}
+
+	slices.SortFunc(mismatches, func(a, b models.BalanceMismatch) int {
+		return bytes.Compare(a.AccountUUID[:], b.AccountUUID[:])
+	})
+
+	return mismatches, int64(len(r.accounts)), nil
+}
+
+// RepairBalance resets the stored balance of an account to the sum of its postings.
+func (r *ReconciliationRepo) RepairBalance(ctx context.Context, accountUUID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	acc, ok := r.accounts[accountUUID]
+	if !ok {
+		return repoerr.ErrNotFound
+	}
+
+	prevUpdatedAt := acc.UpdatedAt
+	r.onRollback(func() { acc.UpdatedAt = prevUpdatedAt })
+
+	updatedAt := now()
+	r.setBalance(accountUUID, r.ledgerBalance(accountUUID, time.Time{}))
+	acc.UpdatedAt = &updatedAt
+
+	return nil
+}
+
+func cloneRun(run *models.ReconciliationRun) models.ReconciliationRun {
+	clone := *run
+	clone.Mismatches = slices.Clone(run.Mismatches)
+	return clone
+}
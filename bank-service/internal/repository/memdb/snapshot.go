@@ -0,0 +1,103 @@
+package memdb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+const _day = 24 * time.Hour
+
+// LastSnapshotDay returns the latest day balances have been snapshotted for, or nil if none.
+func (b *BankRepo) LastSnapshotDay(ctx context.Context) (*time.Time, error) {
+	defer b.rlock(ctx)()
+
+	var last *time.Time
+	for _, snapshots := range b.snapshots {
+		day := snapshots[len(snapshots)-1].day
+		if last == nil || day.After(*last) {
+			last = &day
+		}
+	}
+
+	return last, nil
+}
+
+// SnapshotBalances stores the end of day balances of all accounts. Each balance
+// is the previous snapshot of the account plus the postings made since then.
+func (b *BankRepo) SnapshotBalances(ctx context.Context, day time.Time) (int64, error) {
+	defer b.lock(ctx)()
+
+	day = day.UTC().Truncate(_day)
+
+	var inserted int64
+	for accountUUID := range b.accounts {
+		snapshots := b.snapshots[accountUUID]
+
+		i := len(snapshots)
+		for i > 0 && !snapshots[i-1].day.Before(day) {
+			i--
+		}
+		if i < len(snapshots) && snapshots[i].day.Equal(day) {
+			continue
+		}
+
+		balance := b.balanceSince(accountUUID, snapshots[:i], day.Add(_day))
+		// The clipped slice is copied on insert, so a rollback can restore the old one.
+		b.snapshots[accountUUID] = slices.Insert(slices.Clip(snapshots), i, snapshot{day: day, balance: balance})
+		b.onRollback(func() {
+			if len(snapshots) == 0 {
+				delete(b.snapshots, accountUUID)
+				return
+			}
+			b.snapshots[accountUUID] = snapshots
+		})
+		inserted++
+	}
+
+	return inserted, nil
+}
+
+// BalanceAt sums the latest snapshot taken before at with the postings made since.
+func (b *BankRepo) BalanceAt(ctx context.Context, accountUUID uuid.UUID, at time.Time) (int64, error) {
+	defer b.rlock(ctx)()
+
+	if _, ok := b.accounts[accountUUID]; !ok {
+		return 0, repoerr.ErrNotFound
+	}
+
+	snapshots := b.snapshots[accountUUID]
+	day := at.UTC().Truncate(_day)
+
+	i := len(snapshots)
+	for i > 0 && !snapshots[i-1].day.Before(day) {
+		i--
+	}
+
+	// Postings up to and including at.
+	return b.balanceSince(accountUUID, snapshots[:i], at.Add(time.Microsecond)), nil
+}
+
+// balanceSince adds the postings made before end and after the last of the
+// snapshots to the balance of that snapshot. Must be called with the lock held.
+func (s *Store) balanceSince(accountUUID uuid.UUID, snapshots []snapshot, end time.Time) int64 {
+	var (
+		balance int64
+		begin   time.Time
+	)
+	if len(snapshots) > 0 {
+		last := snapshots[len(snapshots)-1]
+		balance, begin = last.balance, last.day.Add(_day)
+	}
+
+	for _, posting := range s.postings[accountUUID] {
+		if !posting.CreatedAt.Before(begin) && posting.CreatedAt.Before(end) {
+			balance += posting.Amount
+		}
+	}
+
+	return balance
+}
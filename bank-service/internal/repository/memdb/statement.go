@@ -0,0 +1,51 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+)
+
+// Statement computes the balances of the statement and then passes every posting
+// of its period to fn in order. The postings are read under the lock together
+// with the balances, but passed on without it, so a slow reader does not block
+// the writers.
+func (b *BankRepo) Statement(
+	ctx context.Context,
+	statement models.Statement,
+	begin func(models.Statement) error,
+	fn func(models.Posting) error,
+) error {
+	const op = "BankRepo.Statement"
+
+	unlock := b.rlock(ctx)
+	acc, ok := b.accounts[statement.AccountUUID]
+	if !ok {
+		unlock()
+		return repoerr.ErrNotFound
+	}
+	statement.Balance = acc.Balance
+	statement.OpeningBalance = b.ledgerBalance(statement.AccountUUID, statement.From)
+	statement.ClosingBalance = b.ledgerBalance(statement.AccountUUID, statement.To)
+	statement.LedgerBalance = b.ledgerBalance(statement.AccountUUID, time.Time{})
+	postings := b.postings[statement.AccountUUID]
+	unlock()
+
+	if err := begin(statement); err != nil {
+		return fmt.Errorf("%s - begin: %w", op, err)
+	}
+
+	for _, posting := range postings {
+		if posting.CreatedAt.Before(statement.From) || !posting.CreatedAt.Before(statement.To) {
+			continue
+		}
+		if err := fn(posting); err != nil {
+			return fmt.Errorf("%s - fn: %w", op, err)
+		}
+	}
+
+	return nil
+}
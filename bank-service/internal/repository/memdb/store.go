@@ -0,0 +1,132 @@
+package memdb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+// Store keeps all the data of the service in memory, it is lost on restart.
+// Every write holds the lock for its whole duration, so each of them is
+// atomic and isolated like a serializable transaction of the pgdb repositories.
+// Do groups several of them into one unit of work.
+type Store struct {
+	mu sync.RWMutex
+
+	accounts    map[uuid.UUID]*accountRecord
+	externalIDs map[string]uuid.UUID
+	// Postings of every account in creation order. The slices are only ever
+	// appended to, so a slice read under the lock stays a consistent snapshot.
+	postings      map[uuid.UUID][]models.Posting
+	lastPostingID int64
+
+	transfers map[uuid.UUID]*models.Transfer
+	runs      map[uuid.UUID]*models.ReconciliationRun
+	// Snapshots of every account ordered by day.
+	snapshots map[uuid.UUID][]snapshot
+	audit     []models.AuditEntry
+
+	// undo lists the changes of the running unit of work, it is nil outside of one.
+	undo []func()
+}
+
+// accountRecord is an account with the columns that are not part of the model.
+type accountRecord struct {
+	models.Account
+	externalID string
+}
+
+type snapshot struct {
+	day     time.Time
+	balance int64
+}
+
+func NewStore() *Store {
+	return &Store{
+		accounts:    make(map[uuid.UUID]*accountRecord),
+		externalIDs: make(map[string]uuid.UUID),
+		postings:    make(map[uuid.UUID][]models.Posting),
+		transfers:   make(map[uuid.UUID]*models.Transfer),
+		runs:        make(map[uuid.UUID]*models.ReconciliationRun),
+		snapshots:   make(map[uuid.UUID][]snapshot),
+	}
+}
+
+// Ping always succeeds, the store is reachable as long as the process runs.
+func (s *Store) Ping(ctx context.Context) error {
+	return nil
+}
+
+// now returns the current time the way Postgres stores it: in UTC and
+// with microseconds, so timestamps round trip through page tokens alike.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// canPost reports whether a posting can be applied to the account. Must be
+// called with the lock held.
+func (s *Store) canPost(posting models.Posting) error {
+	acc, ok := s.accounts[posting.AccountUUID]
+	if !ok {
+		return repoerr.ErrNotFound
+	}
+	if acc.Balance+posting.Amount < 0 {
+		return repoerr.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// post applies a posting to the account balance and records it in the ledger.
+// Debits never take the balance below zero. Must be called with the write lock held.
+func (s *Store) post(posting models.Posting) error {
+	if err := s.canPost(posting); err != nil {
+		return err
+	}
+
+	s.setBalance(posting.AccountUUID, s.accounts[posting.AccountUUID].Balance+posting.Amount)
+	s.record(posting)
+
+	return nil
+}
+
+// record appends a posting to the ledger without touching the balance.
+// Must be called with the write lock held.
+func (s *Store) record(posting models.Posting) {
+	s.lastPostingID++
+	posting.ID = s.lastPostingID
+	if posting.CreatedAt.IsZero() {
+		posting.CreatedAt = now()
+	}
+	s.postings[posting.AccountUUID] = append(s.postings[posting.AccountUUID], posting)
+
+	s.onRollback(func() {
+		postings := s.postings[posting.AccountUUID]
+		s.postings[posting.AccountUUID] = postings[:len(postings)-1]
+		s.lastPostingID--
+	})
+}
+
+// setBalance changes the stored balance of an existing account.
+// Must be called with the write lock held.
+func (s *Store) setBalance(accountUUID uuid.UUID, balance int64) {
+	acc := s.accounts[accountUUID]
+	prev := acc.Balance
+	acc.Balance = balance
+	s.onRollback(func() { acc.Balance = prev })
+}
+
+// ledgerBalance sums the postings of an account made before end, or all of
+// them if end is zero. Must be called with the lock held.
+func (s *Store) ledgerBalance(accountUUID uuid.UUID, end time.Time) int64 {
+	var balance int64
+	for _, posting := range s.postings[accountUUID] {
+		if end.IsZero() || posting.CreatedAt.Before(end) {
+			balance += posting.Amount
+		}
+	}
+	return balance
+}
@@ -0,0 +1,175 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+type TransferRepo struct {
+	*Store
+}
+
+func NewTransferRepo(store *Store) *TransferRepo {
+	return &TransferRepo{store}
+}
+
+// CreateTransfer stores a pending transfer. Creating a transfer that already
+// exists with the same accounts and amount returns the stored one.
+func (t *TransferRepo) CreateTransfer(ctx context.Context, transfer models.Transfer) (models.Transfer, error) {
+	defer t.lock(ctx)()
+
+	if existing, ok := t.transfers[transfer.UUID]; ok {
+		if existing.FromAccountUUID != transfer.FromAccountUUID ||
+			existing.ToAccountUUID != transfer.ToAccountUUID ||
+			existing.Amount != transfer.Amount {
+			return models.Transfer{}, repoerr.ErrAlreadyExist
+		}
+		return cloneTransfer(existing), nil
+	}
+
+	if _, ok := t.accounts[transfer.FromAccountUUID]; !ok {
+		return models.Transfer{}, repoerr.ErrNotFound
+	}
+	if _, ok := t.accounts[transfer.ToAccountUUID]; !ok {
+		return models.Transfer{}, repoerr.ErrNotFound
+	}
+
+	created := &models.Transfer{
+		UUID:            transfer.UUID,
+		FromAccountUUID: transfer.FromAccountUUID,
+		ToAccountUUID:   transfer.ToAccountUUID,
+		Amount:          transfer.Amount,
+		Status:          models.TransferPending,
+		CreatedAt:       now(),
+	}
+	created.Events = []models.TransferEvent{{
+		ToStatus:  models.TransferPending,
+		CreatedAt: created.CreatedAt,
+	}}
+	t.transfers[created.UUID] = created
+	t.onRollback(func() { delete(t.transfers, created.UUID) })
+
+	return cloneTransfer(created), nil
+}
+
+func (t *TransferRepo) GetTransfer(ctx context.Context, transferUUID uuid.UUID) (models.Transfer, error) {
+	defer t.rlock(ctx)()
+
+	transfer, ok := t.transfers[transferUUID]
+	if !ok {
+		return models.Transfer{}, repoerr.ErrNotFound
+	}
+
+	return cloneTransfer(transfer), nil
+}
+
+// ListTransfers returns the transfers without their events, like pgdb does.
+func (t *TransferRepo) ListTransfers(ctx context.Context, filter models.TransferFilter) ([]models.Transfer, error) {
+	defer t.rlock(ctx)()
+
+	var transfers []models.Transfer
+	for _, transfer := range t.transfers {
+		if filter.AccountUUID != uuid.Nil &&
+			transfer.FromAccountUUID != filter.AccountUUID &&
+			transfer.ToAccountUUID != filter.AccountUUID {
+			continue
+		}
+		if filter.Status != "" && transfer.Status != filter.Status {
+			continue
+		}
+		if filter.After != nil && compareCursor(transfer, *filter.After) <= 0 {
+			continue
+		}
+
+		listed := *transfer
+		listed.Events = nil
+		transfers = append(transfers, listed)
+	}
+
+	slices.SortFunc(transfers, func(a, b models.Transfer) int {
+		return compareCursor(&a, models.TransferCursor{CreatedAt: b.CreatedAt, UUID: b.UUID})
+	})
+
+	if len(transfers) > filter.Limit {
+		transfers = transfers[:filter.Limit]
+	}
+
+	return transfers, nil
+}
+
+// TransitionTransfer moves a transfer into a status that does not involve
+// money movement. Entering a status the transfer has already been in is a no-op.
+func (t *TransferRepo) TransitionTransfer(
+	ctx context.Context,
+	transferUUID uuid.UUID,
+	to models.TransferStatus,
+	reason string,
+) (models.Transfer, error) {
+	defer t.lock(ctx)()
+
+	transfer, ok := t.transfers[transferUUID]
+	if !ok {
+		return models.Transfer{}, repoerr.ErrNotFound
+	}
+
+	if !reached(transfer, to) {
+		if !transfer.Status.CanTransitionTo(to) {
+			return models.Transfer{}, repoerr.ErrIllegalTransition
+		}
+		t.transitionTransfer(transfer, to, reason)
+	}
+
+	return cloneTransfer(transfer), nil
+}
+
+// reached reports whether the transfer has ever been in the status.
+func reached(transfer *models.Transfer, status models.TransferStatus) bool {
+	for _, event := range transfer.Events {
+		if event.ToStatus == status {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionTransfer moves the transfer into the status and records the event.
+// The transition must have been checked. Must be called with the write lock held.
+func (s *Store) transitionTransfer(transfer *models.Transfer, to models.TransferStatus, reason string) {
+	prev := *transfer
+	s.onRollback(func() { *transfer = prev })
+
+	from := transfer.Status
+	updatedAt := now()
+
+	transfer.Status = to
+	if reason != "" {
+		transfer.FailureReason = reason
+	}
+	transfer.UpdatedAt = &updatedAt
+	transfer.Events = append(transfer.Events, models.TransferEvent{
+		FromStatus: &from,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  updatedAt,
+	})
+}
+
+// compareCursor orders transfers by creation time and then by uuid.
+func compareCursor(transfer *models.Transfer, cursor models.TransferCursor) int {
+	if c := transfer.CreatedAt.Compare(cursor.CreatedAt); c != 0 {
+		return c
+	}
+	return bytes.Compare(transfer.UUID[:], cursor.UUID[:])
+}
+
+// cloneTransfer copies a stored transfer, so callers never share its events.
+func cloneTransfer(transfer *models.Transfer) models.Transfer {
+	clone := *transfer
+	clone.Events = slices.Clone(transfer.Events)
+	return clone
+}
@@ -0,0 +1,97 @@
+package memdb
+
+import "context"
+
+type (
+	txKey     struct{}
+	commitKey struct{}
+)
+
+// Do runs fn as a unit of work holding the write lock of the store, so the
+// repositories called with its context see no concurrent changes. Every change
+// made by fn is undone if it fails. Called within a unit of work already, fn
+// joins it. The repositories must be called with the context passed to fn,
+// calling them with another one blocks until the unit of work ends.
+func (s *Store) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inTx(ctx) {
+		return fn(ctx)
+	}
+
+	hooks := &[]func(){}
+	if err := s.do(context.WithValue(ctx, commitKey{}, hooks), fn); err != nil {
+		return err
+	}
+
+	for _, hook := range *hooks {
+		hook()
+	}
+
+	return nil
+}
+
+// AfterCommit runs fn once the unit of work of ctx commits, fn is dropped if
+// the unit of work fails. Outside of a unit of work fn runs right away.
+func (s *Store) AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(commitKey{}).(*[]func()); ok && s.inTx(ctx) {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
+}
+
+// do runs fn holding the write lock, the hooks of the commit run once it is released.
+func (s *Store) do(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.undo = []func(){}
+	committed := false
+	defer func() {
+		if !committed {
+			for i := len(s.undo) - 1; i >= 0; i-- {
+				s.undo[i]()
+			}
+		}
+		s.undo = nil
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, s)); err != nil {
+		return err
+	}
+	committed = true
+
+	return nil
+}
+
+func (s *Store) inTx(ctx context.Context) bool {
+	store, ok := ctx.Value(txKey{}).(*Store)
+	return ok && store == s
+}
+
+// lock takes the write lock unless ctx runs within a unit of work of the
+// store, which holds it already. It returns the function releasing the lock.
+func (s *Store) lock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// rlock is lock for reading.
+func (s *Store) rlock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// onRollback registers the undoing of a change made within a unit of work.
+// Outside of one changes are final and nothing is registered. Must be called
+// with the write lock held.
+func (s *Store) onRollback(undo func()) {
+	if s.undo != nil {
+		s.undo = append(s.undo, undo)
+	}
+}
@@ -0,0 +1,131 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	auditColumns = `id, subject, method, request, code, error, response, created_at, prev_hash, hash`
+
+	// Key of the advisory lock serializing appends to the audit chain.
+	_auditLockKey int64 = 0x61756469746c6f67
+)
+
+type AuditRepo struct {
+	*postgres.Postgres
+}
+
+func NewAuditRepo(pg *postgres.Postgres) *AuditRepo {
+	return &AuditRepo{pg}
+}
+
+// AppendAudit chains the entry to the last one and inserts it. Appends are
+// serialized by an advisory lock, so two entries never share a predecessor.
+func (a *AuditRepo) AppendAudit(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	const op = "AuditRepo.AppendAudit"
+
+	tx, err := a.Begin(ctx)
+	if err != nil {
+		return models.AuditEntry{}, fmt.Errorf("%s - a.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1);`, _auditLockKey); err != nil {
+		return models.AuditEntry{}, fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+
+	prev := []byte{}
+	err = tx.QueryRow(ctx, `SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1;`).Scan(&prev)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return models.AuditEntry{}, fmt.Errorf("%s - tx.QueryRow: %w", op, err)
+	}
+
+	entry.PrevHash = prev
+	entry.Hash = entry.ChainHash(prev)
+
+	sql := `INSERT INTO audit_log (subject, method, request, code, error, response, created_at, prev_hash, hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id;`
+
+	err = tx.QueryRow(ctx, sql,
+		entry.Subject, entry.Method, entry.Request, entry.Code, entry.Error,
+		entry.Response, entry.CreatedAt, entry.PrevHash, entry.Hash,
+	).Scan(&entry.ID)
+	if err != nil {
+		return models.AuditEntry{}, fmt.Errorf("%s - tx.QueryRow: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.AuditEntry{}, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return entry, nil
+}
+
+func (a *AuditRepo) ListAudit(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	const op = "AuditRepo.ListAudit"
+
+	sql := `SELECT ` + auditColumns + ` FROM audit_log
+		WHERE ($1::text = '' OR subject = $1)
+		  AND ($2::text = '' OR method = $2)
+		  AND ($3::timestamp IS NULL OR created_at >= $3)
+		  AND ($4::timestamp IS NULL OR created_at < $4)
+		  AND id > $5
+		ORDER BY id
+		LIMIT $6;`
+
+	rows, err := a.ReadQuerier(ctx).Query(ctx, sql,
+		filter.Subject, filter.Method, filter.From, filter.To, filter.AfterID, filter.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s - a.ReadQuerier.Query: %w", op, err)
+	}
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToStructByName[models.AuditEntry])
+	if err != nil {
+		return nil, fmt.Errorf("%s - pgx.CollectRows: %w", op, err)
+	}
+
+	return entries, nil
+}
+
+// ScanAudit calls fn for every entry in chain order, reading them from one snapshot.
+func (a *AuditRepo) ScanAudit(ctx context.Context, fn func(models.AuditEntry) error) error {
+	const op = "AuditRepo.ScanAudit"
+
+	tx, err := a.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("%s - a.BeginTx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT `+auditColumns+` FROM audit_log ORDER BY id;`)
+	if err != nil {
+		return fmt.Errorf("%s - tx.Query: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry, err := pgx.RowToStructByName[models.AuditEntry](rows)
+		if err != nil {
+			return fmt.Errorf("%s - pgx.RowToStructByName: %w", op, err)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s - rows.Err: %w", op, err)
+	}
+
+	return nil
+}
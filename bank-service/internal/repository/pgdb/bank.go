@@ -10,7 +10,7 @@ import (
 	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgerrcode"
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
@@ -25,11 +25,18 @@ func New(pg *postgres.Postgres) *BankRepo {
 func (b *BankRepo) CreateAccount(ctx context.Context, account models.Account) (uuid.UUID, error) {
 	const op = "BankRepo.CreateAccount"
 
-	sql := `INSERT INTO accounts(account_name, balance) VALUES ($1, $2) RETURNING uuid;`
+	// The opening balance is recorded in the ledger by the same statement.
+	sql := `WITH account AS (
+			INSERT INTO accounts(account_name, balance, owner) VALUES ($1, $2, $3) RETURNING uuid, balance
+		), opening AS (
+			INSERT INTO postings (account_uuid, amount, kind)
+			SELECT uuid, balance, 'opening' FROM account WHERE balance <> 0
+		)
+		SELECT uuid FROM account;`
 
 	var accountUUID uuid.UUID
 
-	err := b.Pool.QueryRow(ctx, sql, account.Name, account.Balance).Scan(&accountUUID)
+	err := b.Querier(ctx).QueryRow(ctx, sql, account.Name, account.Balance, account.Owner).Scan(&accountUUID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -37,7 +44,7 @@ func (b *BankRepo) CreateAccount(ctx context.Context, account models.Account) (u
 				return uuid.Nil, repoerr.ErrAlreadyExist
 			}
 		}
-		return uuid.Nil, fmt.Errorf("%s - b.Pool.QueryRow: %w", op, err)
+		return uuid.Nil, fmt.Errorf("%s - b.Querier.QueryRow: %w", op, err)
 	}
 
 	return accountUUID, nil
@@ -46,16 +53,24 @@ func (b *BankRepo) CreateAccount(ctx context.Context, account models.Account) (u
 func (b *BankRepo) GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Account, error) {
 	const op = "BankRepo.GetAccount"
 
-	sql := `SELECT (uuid, account_name, balance, created_at, updated_at) FROM accounts WHERE uuid = $1;`
+	sql := `SELECT a.uuid, a.account_name, ` + accountBalance + `, a.owner, a.created_at, a.updated_at
+		FROM accounts a WHERE a.uuid = $1;`
 
 	var account models.Account
 
-	err := b.Pool.QueryRow(ctx, sql, accountUUID).Scan(&account)
+	err := b.ReadQuerier(ctx).QueryRow(ctx, sql, accountUUID).Scan(
+		&account.UUID,
+		&account.Name,
+		&account.Balance,
+		&account.Owner,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Account{}, repoerr.ErrNotFound
 		}
-		return models.Account{}, fmt.Errorf("%s - b.Pool.QueryRow: %w", op, err)
+		return models.Account{}, fmt.Errorf("%s - b.ReadQuerier.QueryRow: %w", op, err)
 	}
 
 	return account, nil
@@ -66,72 +81,208 @@ func (b *BankRepo) DeleteAccount(ctx context.Context, accountUUID uuid.UUID) err
 
 	sql := `DELETE FROM accounts WHERE uuid = $1;`
 
-	_, err := b.Pool.Exec(ctx, sql, accountUUID)
+	tag, err := b.Querier(ctx).Exec(ctx, sql, accountUUID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return repoerr.ErrNotFound
-		}
-		return fmt.Errorf("%s - b.Pool.Exec: %w", op, err)
+		return fmt.Errorf("%s - b.Querier.Exec: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrNotFound
 	}
 
 	return nil
 }
 
-func (b *BankRepo) Deposit(ctx context.Context, details models.TransactionDetails) error {
+func (b *BankRepo) Deposit(ctx context.Context, details models.TransactionDetails) (bool, error) {
 	const op = "BankRepo.Deposit"
 
-	sql := `UPDATE accounts SET balance = balance + $1 WHERE uuid = $2;`
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferCompleted)
+	}
 
-	tag, err := b.Pool.Exec(ctx, sql, details.Amount, details.TargetAccountUUID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return repoerr.ErrNotFound
+	if err := post(ctx, b.Querier(ctx), models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      details.Amount,
+		Kind:        models.PostingDeposit,
+	}); err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return false, repoerr.ErrNotFound
 		}
-		return fmt.Errorf("%s - b.Pool.Exec: %w", op, err)
+		return false, fmt.Errorf("%s - post: %w", op, err)
 	}
 
-	if tag.RowsAffected() == 0 {
-		return repoerr.ErrNotFound
+	return true, nil
+}
+
+func (b *BankRepo) Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	const op = "BankRepo.Withdraw"
+
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferDebited)
 	}
 
-	return nil
+	if err := post(ctx, b.Querier(ctx), models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      -details.Amount,
+		Kind:        models.PostingWithdraw,
+	}); err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) || errors.Is(err, repoerr.ErrInsufficientFunds) {
+			return false, err
+		}
+		return false, fmt.Errorf("%s - post: %w", op, err)
+	}
+
+	return true, nil
 }
 
-func (b *BankRepo) Withdraw(ctx context.Context, details models.TransactionDetails) error {
-	const op = "BankRepo.Withdraw"
+func (b *BankRepo) Refund(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	const op = "BankRepo.Refund"
+
+	if details.TransferUUID != uuid.Nil {
+		return b.transferStep(ctx, details, models.TransferRefunded)
+	}
 
-	sql := `UPDATE accounts SET balance = balance - $1 WHERE uuid = $2;`
+	if err := post(ctx, b.Querier(ctx), models.Posting{
+		AccountUUID: details.TargetAccountUUID,
+		Amount:      details.Amount,
+		Kind:        models.PostingRefund,
+	}); err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return false, repoerr.ErrNotFound
+		}
+		return false, fmt.Errorf("%s - post: %w", op, err)
+	}
+
+	return true, nil
+}
 
-	tag, err := b.Pool.Exec(ctx, sql, details.Amount, details.TargetAccountUUID)
+// transferStep moves the money of a transfer step and advances the transfer
+// to the given status in one database transaction. A step that has already
+// been applied is acknowledged without moving the money again, it reports
+// whether the money moved.
+func (b *BankRepo) transferStep(ctx context.Context, details models.TransactionDetails, to models.TransferStatus) (bool, error) {
+	const op = "BankRepo.transferStep"
+
+	tx, err := b.Begin(ctx)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return repoerr.ErrNotFound
+		return false, fmt.Errorf("%s - b.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	transfer, err := lockTransfer(ctx, tx, details.TransferUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return false, repoerr.ErrNotFound
 		}
-		return fmt.Errorf("%s - b.Pool.Exec: %w", op, err)
+		return false, fmt.Errorf("%s - lockTransfer: %w", op, err)
 	}
 
-	if tag.RowsAffected() == 0 {
-		return repoerr.ErrNotFound
+	posting := models.Posting{
+		AccountUUID:  transfer.FromAccountUUID,
+		Amount:       transfer.Amount,
+		Kind:         models.PostingRefund,
+		TransferUUID: &transfer.UUID,
+	}
+	switch to {
+	case models.TransferDebited:
+		posting.Amount, posting.Kind = -transfer.Amount, models.PostingWithdraw
+	case models.TransferCompleted:
+		posting.AccountUUID, posting.Kind = transfer.ToAccountUUID, models.PostingDeposit
 	}
 
-	return nil
-}
+	if details.TargetAccountUUID != posting.AccountUUID || details.Amount != transfer.Amount {
+		return false, repoerr.ErrTransferMismatch
+	}
 
-func (b *BankRepo) Refund(ctx context.Context, details models.TransactionDetails) error {
-	const op = "BankRepo.Refund"
+	applied, err := transitionTransfer(ctx, tx, transfer, to, "")
+	if err != nil {
+		if errors.Is(err, repoerr.ErrIllegalTransition) {
+			return false, repoerr.ErrIllegalTransition
+		}
+		return false, fmt.Errorf("%s - transitionTransfer: %w", op, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	if err := post(ctx, tx, posting); err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) || errors.Is(err, repoerr.ErrInsufficientFunds) {
+			return false, err
+		}
+		return false, fmt.Errorf("%s - post: %w", op, err)
+	}
 
-	sql := `UPDATE accounts SET balance = balance + $1 WHERE uuid = $2;`
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return true, nil
+}
+
+// postSQL credits a sharded account through one of its shards, picked at
+// random, and any other account directly. Debits never take the balance of
+// the account below zero.
+const postSQL = `WITH shard AS (
+		UPDATE balance_shards SET balance = balance + $2::bigint
+		WHERE $2::bigint > 0 AND account_uuid = $1
+		  AND shard = (SELECT floor(random() * shards)::int FROM accounts WHERE uuid = $1)
+		RETURNING account_uuid
+	), account AS (
+		UPDATE accounts SET balance = balance + $2::bigint
+		WHERE uuid = $1 AND balance + $2::bigint >= 0 AND NOT EXISTS (SELECT 1 FROM shard)
+		RETURNING uuid
+	)
+	INSERT INTO postings (account_uuid, amount, kind, transfer_uuid, batch_uuid)
+	SELECT account_uuid, $2::bigint, $3::varchar, $4::uuid, $5::uuid FROM shard
+	UNION ALL
+	SELECT uuid, $2::bigint, $3::varchar, $4::uuid, $5::uuid FROM account;`
+
+// post applies a posting to the account balance and records it in the ledger.
+// Debits never take the balance below zero. A debit the balance of a sharded
+// account is short of takes the money of its shards and is tried again.
+func post(ctx context.Context, q postgres.Querier, posting models.Posting) error {
+	args := []any{
+		posting.AccountUUID,
+		posting.Amount,
+		posting.Kind,
+		posting.TransferUUID,
+		posting.BatchUUID,
+	}
 
-	tag, err := b.Pool.Exec(ctx, sql, details.Amount, details.TargetAccountUUID)
+	tag, err := q.Exec(ctx, postSQL, args...)
 	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var shards int
+	sql := `SELECT shards FROM accounts WHERE uuid = $1;`
+	if err := q.QueryRow(ctx, sql, posting.AccountUUID).Scan(&shards); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return repoerr.ErrNotFound
 		}
-		return fmt.Errorf("%s - b.Pool.Exec: %w", op, err)
+		return err
+	}
+	if shards == 0 || posting.Amount > 0 {
+		return repoerr.ErrInsufficientFunds
+	}
+
+	consolidated, err := consolidate(ctx, q, posting.AccountUUID)
+	if err != nil {
+		return err
+	}
+	if !consolidated {
+		return repoerr.ErrInsufficientFunds
 	}
 
+	tag, err = q.Exec(ctx, postSQL, args...)
+	if err != nil {
+		return err
+	}
 	if tag.RowsAffected() == 0 {
-		return repoerr.ErrNotFound
+		return repoerr.ErrInsufficientFunds
 	}
 
 	return nil
@@ -0,0 +1,144 @@
+package pgdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/jackc/pgx/v5"
+)
+
+// The batch statements never raise an error for an item that cannot be applied.
+// Instead they report whether the accounts exist and whether the money moved,
+// so one failed item does not abort the transaction of the whole batch.
+// Debits are preceded by consolidateSQL, so they see the money of the shards.
+const (
+	batchCreditSQL = `WITH shard AS (
+			UPDATE balance_shards SET balance = balance + $2::bigint
+			WHERE account_uuid = $1
+			  AND shard = (SELECT floor(random() * shards)::int FROM accounts WHERE uuid = $1)
+			RETURNING account_uuid
+		), account AS (
+			UPDATE accounts SET balance = balance + $2::bigint
+			WHERE uuid = $1 AND NOT EXISTS (SELECT 1 FROM shard)
+			RETURNING uuid
+		), credited AS (
+			SELECT account_uuid FROM shard UNION ALL SELECT uuid FROM account
+		), posting AS (
+			INSERT INTO postings (account_uuid, amount, kind, batch_uuid)
+			SELECT account_uuid, $2::bigint, 'deposit', $3::uuid FROM credited
+		)
+		SELECT EXISTS (SELECT 1 FROM credited), EXISTS (SELECT 1 FROM credited);`
+
+	batchDebitSQL = `WITH source AS (
+			SELECT uuid FROM accounts WHERE uuid = $1
+		), account AS (
+			UPDATE accounts SET balance = balance - $2::bigint
+			WHERE uuid = $1 AND balance >= $2::bigint
+			RETURNING uuid
+		), posting AS (
+			INSERT INTO postings (account_uuid, amount, kind, batch_uuid)
+			SELECT uuid, -$2::bigint, 'withdraw', $3::uuid FROM account
+		)
+		SELECT EXISTS (SELECT 1 FROM source), EXISTS (SELECT 1 FROM account);`
+
+	// Both accounts are locked in uuid order to avoid deadlocks between concurrent batches.
+	batchTransferSQL = `WITH locked AS (
+			SELECT uuid, balance FROM accounts WHERE uuid IN ($1, $2) ORDER BY uuid FOR UPDATE
+		), allowed AS (
+			SELECT 1 FROM locked source, locked target
+			WHERE source.uuid = $1 AND target.uuid = $2 AND source.balance >= $3::bigint
+		), account AS (
+			UPDATE accounts
+			SET balance = balance + CASE WHEN uuid = $1 THEN -$3::bigint ELSE $3::bigint END
+			WHERE uuid IN ($1, $2) AND EXISTS (SELECT 1 FROM allowed)
+			RETURNING uuid
+		), posting AS (
+			INSERT INTO postings (account_uuid, amount, kind, batch_uuid)
+			SELECT uuid, CASE WHEN uuid = $1 THEN -$3::bigint ELSE $3::bigint END, 'transfer', $4::uuid
+			FROM account
+		)
+		SELECT (SELECT count(*) FROM locked) = 2, EXISTS (SELECT 1 FROM account);`
+)
+
+// ApplyBatch sends every item of the batch in a single round trip and runs
+// them in one transaction. An all-or-nothing batch is rolled back if any
+// item is rejected, a best-effort batch commits the items that were applied.
+func (b *BankRepo) ApplyBatch(ctx context.Context, batch models.Batch) (models.BatchResult, error) {
+	const op = "BankRepo.ApplyBatch"
+
+	result := models.BatchResult{
+		UUID:  batch.UUID,
+		Items: make([]models.BatchItemResult, len(batch.Items)),
+	}
+
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return models.BatchResult{}, fmt.Errorf("%s - b.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	pgBatch := &pgx.Batch{}
+	for _, item := range batch.Items {
+		switch item.Type {
+		case models.BatchDeposit:
+			pgBatch.Queue(batchCreditSQL, item.ToAccountUUID, item.Amount, batch.UUID)
+		case models.BatchWithdraw:
+			pgBatch.Queue(consolidateSQL, item.FromAccountUUID)
+			pgBatch.Queue(batchDebitSQL, item.FromAccountUUID, item.Amount, batch.UUID)
+		case models.BatchTransfer:
+			pgBatch.Queue(consolidateSQL, item.FromAccountUUID)
+			pgBatch.Queue(batchTransferSQL, item.FromAccountUUID, item.ToAccountUUID, item.Amount, batch.UUID)
+		default:
+			return models.BatchResult{}, fmt.Errorf("%s: unknown item type %q", op, item.Type)
+		}
+	}
+
+	rejected := false
+	results := tx.SendBatch(ctx, pgBatch)
+	for i, item := range batch.Items {
+		if item.Type != models.BatchDeposit {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return models.BatchResult{}, fmt.Errorf("%s - results.Exec: %w", op, err)
+			}
+		}
+
+		var found, applied bool
+		if err := results.QueryRow().Scan(&found, &applied); err != nil {
+			results.Close()
+			return models.BatchResult{}, fmt.Errorf("%s - results.QueryRow: %w", op, err)
+		}
+
+		switch {
+		case applied:
+			result.Items[i] = models.BatchItemResult{Status: models.BatchItemApplied}
+		case !found:
+			result.Items[i] = models.BatchItemResult{Status: models.BatchItemRejected, Err: repoerr.ErrNotFound}
+			rejected = true
+		default:
+			result.Items[i] = models.BatchItemResult{Status: models.BatchItemRejected, Err: repoerr.ErrInsufficientFunds}
+			rejected = true
+		}
+	}
+	if err := results.Close(); err != nil {
+		return models.BatchResult{}, fmt.Errorf("%s - results.Close: %w", op, err)
+	}
+
+	if rejected && batch.Mode == models.BatchAllOrNothing {
+		for i := range result.Items {
+			if result.Items[i].Status == models.BatchItemApplied {
+				result.Items[i].Status = models.BatchItemAborted
+			}
+		}
+		return result, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.BatchResult{}, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+	result.Committed = true
+
+	return result, nil
+}
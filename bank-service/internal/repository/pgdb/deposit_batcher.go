@@ -0,0 +1,248 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// How long a flush may take, so shutdown does not hang on an unreachable database.
+const _depositFlushTimeout = 10 * time.Second
+
+// depositBatchSQL credits every account of the batch once with the sum of its
+// deposits, through a shard picked at random if the account is sharded, and
+// records every deposit in the ledger. It returns the accounts credited,
+// the deposits to the others are not applied.
+const depositBatchSQL = `WITH deposits AS (
+		SELECT account_uuid, amount, n
+		FROM unnest($1::uuid[], $2::bigint[]) WITH ORDINALITY AS d(account_uuid, amount, n)
+	), totals AS (
+		SELECT t.account_uuid, t.amount, floor(random() * a.shards)::int AS shard
+		FROM (SELECT account_uuid, SUM(amount)::bigint AS amount FROM deposits GROUP BY account_uuid) t
+		JOIN accounts a ON a.uuid = t.account_uuid
+		ORDER BY t.account_uuid
+	), shard AS (
+		UPDATE balance_shards s SET balance = s.balance + t.amount
+		FROM totals t
+		WHERE s.account_uuid = t.account_uuid AND s.shard = t.shard
+		RETURNING s.account_uuid
+	), account AS (
+		UPDATE accounts a SET balance = a.balance + t.amount
+		FROM totals t
+		WHERE a.uuid = t.account_uuid AND t.account_uuid NOT IN (SELECT account_uuid FROM shard)
+		RETURNING a.uuid
+	), credited AS (
+		SELECT account_uuid FROM shard UNION ALL SELECT uuid FROM account
+	), posting AS (
+		INSERT INTO postings (account_uuid, amount, kind)
+		SELECT account_uuid, amount, 'deposit' FROM deposits
+		WHERE account_uuid IN (SELECT account_uuid FROM credited)
+		ORDER BY n
+	)
+	SELECT account_uuid FROM credited;`
+
+type depositRequest struct {
+	ctx     context.Context
+	details models.TransactionDetails
+	result  chan error
+}
+
+// DepositBatcher is a BankRepo that writes the standalone deposits made at
+// once in one statement per window, rather than one statement and commit
+// each. A window starts with the first deposit and is cut short once it holds
+// the max number of deposits. Every caller waits for its batch to be written
+// and gets its own error, a batch rejected by the database is retried one
+// deposit at a time so a single bad deposit fails only its caller. Deposits
+// of transfers and the ones made within a unit of work are written by
+// BankRepo as they are.
+type DepositBatcher struct {
+	*BankRepo
+	window    time.Duration
+	maxSize   int
+	txManager *postgres.TxManager
+	// write and deposit write a batch and a single deposit, tests replace them.
+	write   func(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error)
+	deposit func(ctx context.Context, details models.TransactionDetails) (bool, error)
+
+	mu       sync.RWMutex
+	closed   bool
+	requests chan depositRequest
+	done     chan struct{}
+}
+
+func NewDepositBatcher(repo *BankRepo, window time.Duration, maxSize int) *DepositBatcher {
+	d := &DepositBatcher{
+		BankRepo:  repo,
+		window:    window,
+		maxSize:   max(maxSize, 1),
+		txManager: postgres.NewTxManager(repo.Postgres),
+		requests:  make(chan depositRequest, max(maxSize, 1)),
+		done:      make(chan struct{}),
+	}
+	d.write = d.writeBatch
+	d.deposit = repo.Deposit
+
+	go d.run()
+
+	return d
+}
+
+func (d *DepositBatcher) Deposit(ctx context.Context, details models.TransactionDetails) (bool, error) {
+	if _, inTx := d.Querier(ctx).(pgx.Tx); inTx || details.TransferUUID != uuid.Nil {
+		return d.BankRepo.Deposit(ctx, details)
+	}
+
+	req := depositRequest{
+		ctx:     ctx,
+		details: details,
+		result:  make(chan error, 1),
+	}
+
+	d.mu.RLock()
+	if d.closed {
+		d.mu.RUnlock()
+		return d.BankRepo.Deposit(ctx, details)
+	}
+	select {
+	case d.requests <- req:
+	case <-ctx.Done():
+		d.mu.RUnlock()
+		return false, ctx.Err()
+	}
+	d.mu.RUnlock()
+
+	// A deposit whose ctx is done by the time its batch is taken is dropped,
+	// afterwards it is written anyway, so the caller waits to learn whether it was.
+	if err := <-req.result; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Close writes the deposits queued and stops batching, the deposits made
+// afterwards are written one by one.
+func (d *DepositBatcher) Close() {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		close(d.requests)
+	}
+	d.mu.Unlock()
+
+	<-d.done
+}
+
+func (d *DepositBatcher) run() {
+	defer close(d.done)
+
+	for req := range d.requests {
+		batch := []depositRequest{req}
+
+		timer := time.NewTimer(d.window)
+	collect:
+		for len(batch) < d.maxSize {
+			select {
+			case req, ok := <-d.requests:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		d.flush(batch)
+	}
+}
+
+// flush writes the batch and passes every caller its result. If the database
+// rejects the batch, e.g. as the sum of one account overflows, its deposits are
+// written one by one so the others still go through. Any other error leaves it
+// unknown whether the batch was committed, so it fails every deposit.
+func (d *DepositBatcher) flush(batch []depositRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), _depositFlushTimeout)
+	defer cancel()
+
+	pending := batch[:0]
+	for _, req := range batch {
+		if err := req.ctx.Err(); err != nil {
+			req.result <- err
+			continue
+		}
+		pending = append(pending, req)
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	credited, err := d.write(ctx, pending)
+	retry := err != nil && rejected(err) && ctx.Err() == nil
+	for _, req := range pending {
+		switch {
+		case retry:
+			_, err := d.deposit(ctx, req.details)
+			req.result <- err
+		case err != nil:
+			req.result <- err
+		case !credited[req.details.TargetAccountUUID]:
+			req.result <- repoerr.ErrNotFound
+		default:
+			req.result <- nil
+		}
+	}
+}
+
+func (d *DepositBatcher) writeBatch(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error) {
+	const op = "DepositBatcher.writeBatch"
+
+	accounts := make([]uuid.UUID, len(batch))
+	amounts := make([]int64, len(batch))
+	for i, req := range batch {
+		accounts[i] = req.details.TargetAccountUUID
+		amounts[i] = req.details.Amount
+	}
+
+	var credited map[uuid.UUID]bool
+	// Batches of other instances may lock the same accounts in another
+	// order, the deadlocks are retried.
+	err := d.txManager.Do(ctx, func(ctx context.Context) error {
+		rows, err := d.Querier(ctx).Query(ctx, depositBatchSQL, accounts, amounts)
+		if err != nil {
+			return fmt.Errorf("%s - d.Querier.Query: %w", op, err)
+		}
+		uuids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if err != nil {
+			return fmt.Errorf("%s - pgx.CollectRows: %w", op, err)
+		}
+
+		credited = make(map[uuid.UUID]bool, len(uuids))
+		for _, accountUUID := range uuids {
+			credited[accountUUID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return credited, nil
+}
+
+// rejected reports whether the database rejected the batch, which rolls its
+// transaction back. A connection lost on the way, even during the commit,
+// is not reported by the database.
+func rejected(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr)
+}
@@ -0,0 +1,158 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeBatcher is a DepositBatcher without a database, its batches are
+// written by write and its single deposits by deposit.
+func fakeBatcher(
+	write func(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error),
+	deposit func(ctx context.Context, details models.TransactionDetails) (bool, error),
+) *DepositBatcher {
+	return &DepositBatcher{write: write, deposit: deposit}
+}
+
+func newRequest(ctx context.Context, accountUUID uuid.UUID) depositRequest {
+	return depositRequest{
+		ctx:     ctx,
+		details: models.TransactionDetails{TargetAccountUUID: accountUUID, Amount: 10},
+		result:  make(chan error, 1),
+	}
+}
+
+func TestFlushCreditsBatch(t *testing.T) {
+	known, unknown := uuid.New(), uuid.New()
+	d := fakeBatcher(
+		func(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error) {
+			return map[uuid.UUID]bool{known: true}, nil
+		},
+		func(ctx context.Context, details models.TransactionDetails) (bool, error) {
+			t.Error("deposit written by itself")
+			return true, nil
+		},
+	)
+
+	credited := newRequest(context.Background(), known)
+	notFound := newRequest(context.Background(), unknown)
+	d.flush([]depositRequest{credited, notFound})
+
+	if err := <-credited.result; err != nil {
+		t.Errorf("credited: got %v, want nil", err)
+	}
+	if err := <-notFound.result; !errors.Is(err, repoerr.ErrNotFound) {
+		t.Errorf("unknown account: got %v, want %v", err, repoerr.ErrNotFound)
+	}
+}
+
+func TestFlushDropsCancelledDeposits(t *testing.T) {
+	live := newRequest(context.Background(), uuid.New())
+
+	var written []depositRequest
+	d := fakeBatcher(
+		func(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error) {
+			written = append(written, batch...)
+			return map[uuid.UUID]bool{live.details.TargetAccountUUID: true}, nil
+		},
+		nil,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cancelled := newRequest(ctx, uuid.New())
+	d.flush([]depositRequest{cancelled, live})
+
+	if err := <-cancelled.result; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled: got %v, want %v", err, context.Canceled)
+	}
+	if err := <-live.result; err != nil {
+		t.Errorf("live: got %v, want nil", err)
+	}
+	if len(written) != 1 || written[0].details != live.details {
+		t.Errorf("written: got %d deposits, want the live one", len(written))
+	}
+
+	// A batch of cancelled deposits only is not written.
+	written = nil
+	cancelled = newRequest(ctx, uuid.New())
+	d.flush([]depositRequest{cancelled})
+	if err := <-cancelled.result; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled alone: got %v, want %v", err, context.Canceled)
+	}
+	if len(written) != 0 {
+		t.Errorf("written: got %d deposits, want none", len(written))
+	}
+}
+
+func TestFlushBatchError(t *testing.T) {
+	errOverflow := &pgconn.PgError{Code: pgerrcode.NumericValueOutOfRange}
+	errConnLost := errors.New("unexpected EOF")
+
+	tests := []struct {
+		name string
+		err  error
+		// retried tells whether the deposits are written one by one,
+		// the second of them fails then.
+		retried bool
+	}{
+		{name: "rejected by the database", err: errOverflow, retried: true},
+		{name: "wrapped rejection", err: fmt.Errorf("op: %w", errOverflow), retried: true},
+		{name: "connection lost", err: errConnLost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overflowing := uuid.New()
+
+			var deposits int
+			d := fakeBatcher(
+				func(ctx context.Context, batch []depositRequest) (map[uuid.UUID]bool, error) {
+					return nil, tt.err
+				},
+				func(ctx context.Context, details models.TransactionDetails) (bool, error) {
+					deposits++
+					if details.TargetAccountUUID == overflowing {
+						return false, errOverflow
+					}
+					return true, nil
+				},
+			)
+
+			batch := []depositRequest{
+				newRequest(context.Background(), uuid.New()),
+				newRequest(context.Background(), overflowing),
+				newRequest(context.Background(), uuid.New()),
+			}
+			d.flush(batch)
+
+			for i, req := range batch {
+				err := <-req.result
+				switch {
+				case !tt.retried && !errors.Is(err, tt.err):
+					t.Errorf("deposit #%d: got %v, want %v", i, err, tt.err)
+				case tt.retried && i == 1 && !errors.Is(err, errOverflow):
+					t.Errorf("deposit #%d: got %v, want %v", i, err, errOverflow)
+				case tt.retried && i != 1 && err != nil:
+					t.Errorf("deposit #%d: got %v, want nil", i, err)
+				}
+			}
+
+			want := 0
+			if tt.retried {
+				want = len(batch)
+			}
+			if deposits != want {
+				t.Errorf("deposits written one by one: got %d, want %d", deposits, want)
+			}
+		})
+	}
+}
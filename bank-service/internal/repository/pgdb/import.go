@@ -0,0 +1,82 @@
+package pgdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ImportAccounts copies the accounts into a temporary table and inserts the
+// ones whose external id is not known yet, together with their opening postings.
+// It returns the uuids of the inserted accounts by external id.
+func (b *BankRepo) ImportAccounts(ctx context.Context, accounts []models.AccountImport) (map[string]uuid.UUID, error) {
+	const op = "BankRepo.ImportAccounts"
+
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s - b.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql := `CREATE TEMPORARY TABLE account_imports (
+			external_id varchar(255) NOT NULL,
+			account_name varchar(255) NOT NULL,
+			balance bigint NOT NULL,
+			owner varchar(255) NOT NULL
+		) ON COMMIT DROP;`
+	if _, err := tx.Exec(ctx, sql); err != nil {
+		return nil, fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"account_imports"},
+		[]string{"external_id", "account_name", "balance", "owner"},
+		pgx.CopyFromSlice(len(accounts), func(i int) ([]any, error) {
+			return []any{accounts[i].ExternalID, accounts[i].Name, accounts[i].Balance, accounts[i].Owner}, nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s - tx.CopyFrom: %w", op, err)
+	}
+
+	sql = `WITH account AS (
+			INSERT INTO accounts (external_id, account_name, balance, owner)
+			SELECT external_id, account_name, balance, owner FROM account_imports
+			ON CONFLICT (external_id) DO NOTHING
+			RETURNING uuid, external_id, balance
+		), opening AS (
+			INSERT INTO postings (account_uuid, amount, kind)
+			SELECT uuid, balance, 'opening' FROM account WHERE balance <> 0
+		)
+		SELECT external_id, uuid FROM account;`
+
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("%s - tx.Query: %w", op, err)
+	}
+
+	imported := make(map[string]uuid.UUID, len(accounts))
+	for rows.Next() {
+		var (
+			externalID  string
+			accountUUID uuid.UUID
+		)
+		if err := rows.Scan(&externalID, &accountUUID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s - rows.Scan: %w", op, err)
+		}
+		imported[externalID] = accountUUID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s - rows.Err: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return imported, nil
+}
@@ -0,0 +1,357 @@
+//go:build integration
+
+package pgdb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/pgdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/google/uuid"
+)
+
+// The tests run against the database at PG_URL, see openPostgres:
+//
+//	PG_URL=postgres://... go test -tags integration ./internal/repository/pgdb
+
+func createAccount(t *testing.T, repo *pgdb.BankRepo, balance int64) uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+	accountUUID, err := repo.CreateAccount(ctx, models.Account{Name: "integration", Balance: balance})
+	if err != nil {
+		t.Fatalf("CreateAccount: %v", err)
+	}
+	t.Cleanup(func() { _ = repo.DeleteAccount(ctx, accountUUID) })
+	return accountUUID
+}
+
+// checkBalance checks the balance of the account and that its ledger adds up to it.
+func checkBalance(t *testing.T, pg *postgres.Postgres, repo *pgdb.BankRepo, accountUUID uuid.UUID, want int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	account, err := repo.GetAccount(ctx, accountUUID)
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if account.Balance != want {
+		t.Errorf("balance: got %d, want %d", account.Balance, want)
+	}
+
+	var ledger int64
+	err = pg.Pool.QueryRow(ctx, `SELECT COALESCE(SUM(amount), 0) FROM postings WHERE account_uuid = $1`, accountUUID).Scan(&ledger)
+	if err != nil {
+		t.Fatalf("sum postings: %v", err)
+	}
+	if ledger != want {
+		t.Errorf("ledger: got %d, want %d", ledger, want)
+	}
+}
+
+func TestShardedBalance(t *testing.T) {
+	ctx := context.Background()
+	pg := openPostgres(t)
+	repo := pgdb.New(pg)
+
+	accountUUID := createAccount(t, repo, 10)
+	if err := repo.ShardBalance(ctx, accountUUID, 4); err != nil {
+		t.Fatalf("ShardBalance: %v", err)
+	}
+
+	for range 20 {
+		if _, err := repo.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: accountUUID, Amount: 5}); err != nil {
+			t.Fatalf("Deposit: %v", err)
+		}
+	}
+	checkBalance(t, pg, repo, accountUUID, 110)
+
+	// The credits went to the shards, the account alone is short of it.
+	if _, err := repo.Withdraw(ctx, models.TransactionDetails{TargetAccountUUID: accountUUID, Amount: 100}); err != nil {
+		t.Fatalf("Withdraw: %v", err)
+	}
+	checkBalance(t, pg, repo, accountUUID, 10)
+
+	_, err := repo.Withdraw(ctx, models.TransactionDetails{TargetAccountUUID: accountUUID, Amount: 11})
+	if !errors.Is(err, repoerr.ErrInsufficientFunds) {
+		t.Errorf("Withdraw past the balance: got %v, want %v", err, repoerr.ErrInsufficientFunds)
+	}
+	checkBalance(t, pg, repo, accountUUID, 10)
+
+	if _, err := repo.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: accountUUID, Amount: 7}); err != nil {
+		t.Fatalf("Deposit: %v", err)
+	}
+	if err := repo.ShardBalance(ctx, accountUUID, 0); err != nil {
+		t.Fatalf("ShardBalance unshard: %v", err)
+	}
+	checkBalance(t, pg, repo, accountUUID, 17)
+
+	err = repo.ShardBalances(ctx, []uuid.UUID{accountUUID, uuid.New()}, 2)
+	if !errors.Is(err, repoerr.ErrNotFound) {
+		t.Errorf("ShardBalances with an unknown account: got %v, want %v", err, repoerr.ErrNotFound)
+	}
+	if err := repo.ShardBalance(ctx, uuid.New(), 2); !errors.Is(err, repoerr.ErrNotFound) {
+		t.Errorf("ShardBalance of an unknown account: got %v, want %v", err, repoerr.ErrNotFound)
+	}
+}
+
+func TestDepositBatcher(t *testing.T) {
+	ctx := context.Background()
+	pg := openPostgres(t)
+	repo := pgdb.New(pg)
+
+	plain := createAccount(t, repo, 0)
+	sharded := createAccount(t, repo, 0)
+	if err := repo.ShardBalance(ctx, sharded, 4); err != nil {
+		t.Fatalf("ShardBalance: %v", err)
+	}
+	full := createAccount(t, repo, math.MaxInt64-5)
+
+	tests := []struct {
+		name    string
+		account uuid.UUID
+		amount  int64
+		count   int
+		fails   bool
+		// err is the error of a failed deposit, any if nil.
+		err error
+	}{
+		{name: "plain", account: plain, amount: 3, count: 20},
+		{name: "sharded", account: sharded, amount: 2, count: 20},
+		{name: "unknown", account: uuid.New(), amount: 1, count: 2, fails: true, err: repoerr.ErrNotFound},
+		// The overflow rejects the batch, only this deposit fails once
+		// the deposits are written one by one.
+		{name: "overflow", account: full, amount: 10, count: 1, fails: true},
+	}
+
+	d := pgdb.NewDepositBatcher(repo, 50*time.Millisecond, 64)
+
+	var wg sync.WaitGroup
+	errs := make([][]error, len(tests))
+	for i, tt := range tests {
+		errs[i] = make([]error, tt.count)
+		for j := range tt.count {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i][j] = d.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: tt.account, Amount: tt.amount})
+			}()
+		}
+	}
+	wg.Wait()
+	d.Close()
+
+	for i, tt := range tests {
+		for _, err := range errs[i] {
+			switch {
+			case !tt.fails && err != nil:
+				t.Errorf("%s: Deposit: %v", tt.name, err)
+			case tt.fails && err == nil:
+				t.Errorf("%s: deposit applied", tt.name)
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Errorf("%s: got %v, want %v", tt.name, err, tt.err)
+			}
+		}
+	}
+
+	checkBalance(t, pg, repo, plain, 60)
+	checkBalance(t, pg, repo, sharded, 40)
+	checkBalance(t, pg, repo, full, math.MaxInt64-5)
+}
+
+func TestImportAccounts(t *testing.T) {
+	ctx := context.Background()
+	pg := openPostgres(t)
+	repo := pgdb.New(pg)
+
+	prefix := uuid.NewString()
+	rows := func(from, to int) []models.AccountImport {
+		accounts := make([]models.AccountImport, 0, to-from)
+		for i := from; i < to; i++ {
+			accounts = append(accounts, models.AccountImport{
+				ExternalID: fmt.Sprintf("%s-%d", prefix, i),
+				Name:       "imported",
+				Balance:    int64(i % 3 * 100),
+				Owner:      "importer",
+			})
+		}
+		return accounts
+	}
+	cleanup := func(imported map[string]uuid.UUID) {
+		t.Cleanup(func() {
+			for _, accountUUID := range imported {
+				_ = repo.DeleteAccount(ctx, accountUUID)
+			}
+		})
+	}
+
+	first := rows(0, 6)
+	imported, err := repo.ImportAccounts(ctx, first)
+	if err != nil {
+		t.Fatalf("ImportAccounts: %v", err)
+	}
+	cleanup(imported)
+	if len(imported) != len(first) {
+		t.Fatalf("imported %d accounts, want %d", len(imported), len(first))
+	}
+	for _, row := range first {
+		accountUUID, ok := imported[row.ExternalID]
+		if !ok {
+			t.Fatalf("%s not imported", row.ExternalID)
+		}
+		checkBalance(t, pg, repo, accountUUID, row.Balance)
+	}
+
+	// The rows imported before are skipped.
+	again, err := repo.ImportAccounts(ctx, rows(3, 9))
+	if err != nil {
+		t.Fatalf("ImportAccounts again: %v", err)
+	}
+	cleanup(again)
+	if len(again) != 3 {
+		t.Errorf("imported %d accounts again, want 3", len(again))
+	}
+	for _, row := range first {
+		if _, ok := again[row.ExternalID]; ok {
+			t.Errorf("%s imported twice", row.ExternalID)
+		}
+	}
+}
+
+func TestTransferStateMachine(t *testing.T) {
+	ctx := context.Background()
+	pg := openPostgres(t)
+	repo := pgdb.New(pg)
+	transfers := pgdb.NewTransferRepo(pg)
+
+	from := createAccount(t, repo, 100)
+	to := createAccount(t, repo, 0)
+
+	create := func(amount int64) models.Transfer {
+		t.Helper()
+
+		transfer, err := transfers.CreateTransfer(ctx, models.Transfer{
+			UUID:            uuid.New(),
+			FromAccountUUID: from,
+			ToAccountUUID:   to,
+			Amount:          amount,
+		})
+		if err != nil {
+			t.Fatalf("CreateTransfer: %v", err)
+		}
+		return transfer
+	}
+
+	type step struct {
+		name string
+		// do returns whether the step moved money.
+		do    func(transfer models.Transfer) (bool, error)
+		moved bool
+		err   error
+	}
+	withdraw := func(transfer models.Transfer) (bool, error) {
+		return repo.Withdraw(ctx, models.TransactionDetails{TargetAccountUUID: from, Amount: transfer.Amount, TransferUUID: transfer.UUID})
+	}
+	deposit := func(transfer models.Transfer) (bool, error) {
+		return repo.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: to, Amount: transfer.Amount, TransferUUID: transfer.UUID})
+	}
+	refund := func(transfer models.Transfer) (bool, error) {
+		return repo.Refund(ctx, models.TransactionDetails{TargetAccountUUID: from, Amount: transfer.Amount, TransferUUID: transfer.UUID})
+	}
+	transition := func(status models.TransferStatus) func(transfer models.Transfer) (bool, error) {
+		return func(transfer models.Transfer) (bool, error) {
+			_, err := transfers.TransitionTransfer(ctx, transfer.UUID, status, "integration")
+			return false, err
+		}
+	}
+
+	tests := []struct {
+		name   string
+		amount int64
+		steps  []step
+		want   models.TransferStatus
+	}{
+		{
+			name:   "completed",
+			amount: 30,
+			steps: []step{
+				{name: "withdraw", do: withdraw, moved: true},
+				{name: "withdraw replayed", do: withdraw},
+				{name: "deposit to the wrong account", do: func(transfer models.Transfer) (bool, error) {
+					return repo.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: from, Amount: transfer.Amount, TransferUUID: transfer.UUID})
+				}, err: repoerr.ErrTransferMismatch},
+				{name: "deposit", do: deposit, moved: true},
+				{name: "deposit replayed", do: deposit},
+				{name: "refund", do: refund, err: repoerr.ErrIllegalTransition},
+			},
+			want: models.TransferCompleted,
+		},
+		{
+			name:   "refunded",
+			amount: 20,
+			steps: []step{
+				{name: "withdraw", do: withdraw, moved: true},
+				{name: "refund before compensating", do: refund, err: repoerr.ErrIllegalTransition},
+				{name: "compensating", do: transition(models.TransferCompensating)},
+				{name: "deposit", do: deposit, err: repoerr.ErrIllegalTransition},
+				{name: "refund", do: refund, moved: true},
+				{name: "refund replayed", do: refund},
+			},
+			want: models.TransferRefunded,
+		},
+		{
+			name:   "failed before the withdrawal",
+			amount: 10,
+			steps: []step{
+				{name: "failed", do: transition(models.TransferFailed)},
+				{name: "failed again", do: transition(models.TransferFailed)},
+				{name: "withdraw", do: withdraw, err: repoerr.ErrIllegalTransition},
+			},
+			want: models.TransferFailed,
+		},
+		{
+			name:   "insufficient funds",
+			amount: 1000,
+			steps: []step{
+				{name: "withdraw", do: withdraw, err: repoerr.ErrInsufficientFunds},
+			},
+			want: models.TransferPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := create(tt.amount)
+
+			for _, s := range tt.steps {
+				moved, err := s.do(transfer)
+				if !errors.Is(err, s.err) {
+					t.Fatalf("%s: got %v, want %v", s.name, err, s.err)
+				}
+				if moved != s.moved {
+					t.Errorf("%s: moved %v, want %v", s.name, moved, s.moved)
+				}
+			}
+
+			got, err := transfers.GetTransfer(ctx, transfer.UUID)
+			if err != nil {
+				t.Fatalf("GetTransfer: %v", err)
+			}
+			if got.Status != tt.want {
+				t.Errorf("status: got %s, want %s", got.Status, tt.want)
+			}
+		})
+	}
+
+	// 30 completed, 20 withdrawn and refunded.
+	checkBalance(t, pg, repo, from, 70)
+	checkBalance(t, pg, repo, to, 30)
+}
@@ -0,0 +1,29 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// AccountOwner reads from the primary, so the owner may use an account right
+// after creating it.
+func (b *BankRepo) AccountOwner(ctx context.Context, accountUUID uuid.UUID) (string, error) {
+	const op = "BankRepo.AccountOwner"
+
+	sql := `SELECT owner FROM accounts WHERE uuid = $1;`
+
+	var owner string
+	if err := b.Querier(ctx).QueryRow(ctx, sql, accountUUID).Scan(&owner); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repoerr.ErrNotFound
+		}
+		return "", fmt.Errorf("%s - b.Querier.QueryRow: %w", op, err)
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,39 @@
+package pgdb_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/migrations"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+)
+
+// openPostgres connects to the database at PG_URL and applies the migrations.
+// The tests and benchmarks create their own rows and leave the others alone,
+// still PG_URL must point at a scratch database.
+func openPostgres(tb testing.TB) *postgres.Postgres {
+	tb.Helper()
+
+	url := os.Getenv("PG_URL")
+	if url == "" {
+		tb.Skip("PG_URL is not set")
+	}
+
+	pg, err := postgres.New(url, postgres.MaxPoolSize(64))
+	if err != nil {
+		tb.Fatalf("postgres.New: %v", err)
+	}
+	tb.Cleanup(pg.Close)
+
+	migrator, err := postgres.NewMigrator(pg, migrations.FS)
+	if err != nil {
+		tb.Fatalf("postgres.NewMigrator: %v", err)
+	}
+	defer migrator.Close()
+	if _, err := migrator.Up(context.Background()); err != nil {
+		tb.Fatalf("migrator.Up: %v", err)
+	}
+
+	return pg
+}
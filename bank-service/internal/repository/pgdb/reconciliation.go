@@ -0,0 +1,175 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const reconciliationColumns = `uuid, trigger, repair, status, accounts_checked, mismatches, error, started_at, finished_at`
+
+type ReconciliationRepo struct {
+	*postgres.Postgres
+}
+
+func NewReconciliationRepo(pg *postgres.Postgres) *ReconciliationRepo {
+	return &ReconciliationRepo{pg}
+}
+
+func (r *ReconciliationRepo) CreateRun(ctx context.Context, run models.ReconciliationRun) (models.ReconciliationRun, error) {
+	const op = "ReconciliationRepo.CreateRun"
+
+	sql := `INSERT INTO reconciliation_runs (trigger, repair, status) VALUES ($1, $2, $3)
+		RETURNING ` + reconciliationColumns
+
+	rows, err := r.Querier(ctx).Query(ctx, sql, run.Trigger, run.Repair, models.ReconciliationRunning)
+	if err != nil {
+		return models.ReconciliationRun{}, fmt.Errorf("%s - r.Querier.Query: %w", op, err)
+	}
+
+	created, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[models.ReconciliationRun])
+	if err != nil {
+		return models.ReconciliationRun{}, fmt.Errorf("%s - pgx.CollectExactlyOneRow: %w", op, err)
+	}
+
+	return created, nil
+}
+
+func (r *ReconciliationRepo) FinishRun(ctx context.Context, run models.ReconciliationRun) error {
+	const op = "ReconciliationRepo.FinishRun"
+
+	sql := `UPDATE reconciliation_runs
+		SET status = $2, accounts_checked = $3, mismatches = $4, error = $5, finished_at = NOW()
+		WHERE uuid = $1;`
+
+	mismatches := run.Mismatches
+	if mismatches == nil {
+		mismatches = []models.BalanceMismatch{}
+	}
+
+	if _, err := r.Querier(ctx).Exec(ctx, sql, run.UUID, run.Status, run.AccountsChecked, mismatches, run.Error); err != nil {
+		return fmt.Errorf("%s - r.Querier.Exec: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *ReconciliationRepo) GetRun(ctx context.Context, runUUID uuid.UUID) (models.ReconciliationRun, error) {
+	const op = "ReconciliationRepo.GetRun"
+
+	sql := `SELECT ` + reconciliationColumns + ` FROM reconciliation_runs WHERE uuid = $1;`
+
+	rows, err := r.Querier(ctx).Query(ctx, sql, runUUID)
+	if err != nil {
+		return models.ReconciliationRun{}, fmt.Errorf("%s - r.Querier.Query: %w", op, err)
+	}
+
+	run, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[models.ReconciliationRun])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ReconciliationRun{}, repoerr.ErrNotFound
+		}
+		return models.ReconciliationRun{}, fmt.Errorf("%s - pgx.CollectExactlyOneRow: %w", op, err)
+	}
+
+	return run, nil
+}
+
+// FindMismatches compares every account balance with the sum of its postings.
+// Both are read from one snapshot, so movements made meanwhile are not reported.
+func (r *ReconciliationRepo) FindMismatches(ctx context.Context) ([]models.BalanceMismatch, int64, error) {
+	const op = "ReconciliationRepo.FindMismatches"
+
+	tx, err := r.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s - r.BeginTx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var checked int64
+	if err := tx.QueryRow(ctx, `SELECT count(*) FROM accounts;`).Scan(&checked); err != nil {
+		return nil, 0, fmt.Errorf("%s - tx.QueryRow: %w", op, err)
+	}
+
+	sql := `SELECT a.uuid, a.balance + COALESCE(s.balance, 0), COALESCE(l.amount, 0)
+		FROM accounts a
+		LEFT JOIN (SELECT account_uuid, SUM(balance) AS balance FROM balance_shards GROUP BY account_uuid) s
+			ON s.account_uuid = a.uuid
+		LEFT JOIN (SELECT account_uuid, SUM(amount) AS amount FROM postings GROUP BY account_uuid) l
+			ON l.account_uuid = a.uuid
+		WHERE a.balance + COALESCE(s.balance, 0) <> COALESCE(l.amount, 0)
+		ORDER BY a.uuid;`
+
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s - tx.Query: %w", op, err)
+	}
+	defer rows.Close()
+
+	var mismatches []models.BalanceMismatch
+	for rows.Next() {
+		var m models.BalanceMismatch
+		if err := rows.Scan(&m.AccountUUID, &m.Balance, &m.LedgerBalance); err != nil {
+			return nil, 0, fmt.Errorf("%s - rows.Scan: %w", op, err)
+		}
+		mismatches = append(mismatches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s - rows.Err: %w", op, err)
+	}
+
+	return mismatches, checked, nil
+}
+
+// RepairBalance resets the stored balance of an account to the sum of its postings
+// and empties its shards. The shards and then the account are locked first,
+// so the sum includes every movement committed before.
+func (r *ReconciliationRepo) RepairBalance(ctx context.Context, accountUUID uuid.UUID) error {
+	const op = "ReconciliationRepo.RepairBalance"
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - r.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql := `SELECT 1 FROM balance_shards WHERE account_uuid = $1 ORDER BY shard FOR UPDATE;`
+	if _, err := tx.Exec(ctx, sql, accountUUID); err != nil {
+		return fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+
+	tag, err := tx.Exec(ctx, `SELECT 1 FROM accounts WHERE uuid = $1 FOR UPDATE;`, accountUUID)
+	if err != nil {
+		return fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	sql = `UPDATE balance_shards SET balance = 0 WHERE account_uuid = $1 AND balance <> 0;`
+	if _, err := tx.Exec(ctx, sql, accountUUID); err != nil {
+		return fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+
+	sql = `UPDATE accounts
+		SET balance = (SELECT COALESCE(SUM(amount), 0) FROM postings WHERE account_uuid = $1), updated_at = NOW()
+		WHERE uuid = $1;`
+	if _, err := tx.Exec(ctx, sql, accountUUID); err != nil {
+		return fmt.Errorf("%s - tx.Exec: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
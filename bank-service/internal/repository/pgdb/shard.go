@@ -0,0 +1,124 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// accountBalance is the balance of the account aliased as a, the balances of its shards included.
+const accountBalance = `a.balance + COALESCE((SELECT SUM(s.balance) FROM balance_shards s WHERE s.account_uuid = a.uuid), 0)`
+
+// consolidateSQL moves the balances of the shards of an account to the account.
+// The shards are locked before the account, like credits lock them, and
+// the account is left alone if its shards are empty.
+const consolidateSQL = `WITH shards AS (
+		SELECT shard, balance FROM balance_shards
+		WHERE account_uuid = $1 AND balance > 0
+		ORDER BY shard
+		FOR UPDATE
+	), drained AS (
+		UPDATE balance_shards s SET balance = 0
+		FROM shards WHERE s.account_uuid = $1 AND s.shard = shards.shard
+	)
+	UPDATE accounts SET balance = balance + (SELECT SUM(balance) FROM shards)
+	WHERE uuid = $1 AND EXISTS (SELECT 1 FROM shards);`
+
+// consolidate moves the balances of the shards of the account to the account,
+// it reports whether there was anything to move.
+func consolidate(ctx context.Context, q postgres.Querier, accountUUID uuid.UUID) (bool, error) {
+	tag, err := q.Exec(ctx, consolidateSQL, accountUUID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ShardBalances spreads the credits of every account over the number of
+// shards, which lets them be credited concurrently. Zero unshards them.
+// Nothing is changed if any of the accounts does not exist.
+func (b *BankRepo) ShardBalances(ctx context.Context, accounts []uuid.UUID, shards int) error {
+	const op = "BankRepo.ShardBalances"
+
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - b.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, accountUUID := range accounts {
+		if err := shardBalance(ctx, tx, accountUUID, shards); err != nil {
+			if errors.Is(err, repoerr.ErrNotFound) {
+				return fmt.Errorf("%s: account %s: %w", op, accountUUID, repoerr.ErrNotFound)
+			}
+			return fmt.Errorf("%s - shardBalance: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// ShardBalance spreads the credits of the account over the number of shards,
+// zero unshards it. The balances of the shards dropped go to the account.
+func (b *BankRepo) ShardBalance(ctx context.Context, accountUUID uuid.UUID, shards int) error {
+	const op = "BankRepo.ShardBalance"
+
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - b.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := shardBalance(ctx, tx, accountUUID, shards); err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return repoerr.ErrNotFound
+		}
+		return fmt.Errorf("%s - shardBalance: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+func shardBalance(ctx context.Context, tx pgx.Tx, accountUUID uuid.UUID, shards int) error {
+	if shards < 0 {
+		return fmt.Errorf("negative number of shards %d", shards)
+	}
+
+	// The shards dropped are deleted before the account is locked, like
+	// consolidateSQL does. Credits that picked one of them meanwhile go to the account.
+	sql := `WITH dropped AS (
+			DELETE FROM balance_shards WHERE account_uuid = $1 AND shard >= $2 RETURNING balance
+		)
+		UPDATE accounts SET balance = balance + (SELECT COALESCE(SUM(balance), 0) FROM dropped), shards = $2
+		WHERE uuid = $1;`
+
+	tag, err := tx.Exec(ctx, sql, accountUUID, shards)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	sql = `INSERT INTO balance_shards (account_uuid, shard)
+		SELECT $1::uuid, generate_series(0, $2::int - 1)
+		ON CONFLICT DO NOTHING;`
+	if _, err := tx.Exec(ctx, sql, accountUUID, shards); err != nil {
+		return err
+	}
+
+	return nil
+}
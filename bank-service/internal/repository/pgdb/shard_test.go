@@ -0,0 +1,63 @@
+package pgdb_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/pgdb"
+)
+
+// BenchmarkDepositHotAccount measures concurrent deposits into a single account
+// with its balance unsharded and spread over shards. It creates an account per
+// run in the database at PG_URL, see openPostgres:
+//
+//	PG_URL=postgres://... go test -run '^$' -bench DepositHotAccount ./internal/repository/pgdb
+func BenchmarkDepositHotAccount(b *testing.B) {
+	ctx := context.Background()
+	repo := pgdb.New(openPostgres(b))
+
+	for _, shards := range []int{0, 4, 16} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			accountUUID, err := repo.CreateAccount(ctx, models.Account{Name: "benchmark"})
+			if err != nil {
+				b.Fatalf("CreateAccount: %v", err)
+			}
+			b.Cleanup(func() { _ = repo.DeleteAccount(ctx, accountUUID) })
+
+			if err := repo.ShardBalance(ctx, accountUUID, shards); err != nil {
+				b.Fatalf("ShardBalance: %v", err)
+			}
+
+			deposit := models.TransactionDetails{
+				TargetAccountUUID: accountUUID,
+				Amount:            1,
+			}
+
+			var failed atomic.Int64
+			// Deposits wait on the database rather than the CPU, so many
+			// more of them than there are CPUs run at once.
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := repo.Deposit(ctx, deposit); err != nil {
+						failed.Add(1)
+					}
+				}
+			})
+			b.StopTimer()
+
+			account, err := repo.GetAccount(ctx, accountUUID)
+			if err != nil {
+				b.Fatalf("GetAccount: %v", err)
+			}
+			if want := int64(b.N) - failed.Load(); account.Balance != want {
+				b.Errorf("balance %d does not match %d deposits", account.Balance, want)
+			}
+			b.ReportMetric(float64(failed.Load()), "failed")
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// LastSnapshotDay returns the latest day balances have been snapshotted for, or nil if none.
+func (b *BankRepo) LastSnapshotDay(ctx context.Context) (*time.Time, error) {
+	const op = "BankRepo.LastSnapshotDay"
+
+	var day *time.Time
+	if err := b.Querier(ctx).QueryRow(ctx, `SELECT max(day) FROM balance_snapshots;`).Scan(&day); err != nil {
+		return nil, fmt.Errorf("%s - b.Querier.QueryRow: %w", op, err)
+	}
+
+	return day, nil
+}
+
+// SnapshotBalances stores the end of day balances of all accounts. Each balance
+// is the previous snapshot of the account plus the postings made since then,
+// so only the postings of the days that follow the previous snapshot are read.
+func (b *BankRepo) SnapshotBalances(ctx context.Context, day time.Time) (int64, error) {
+	const op = "BankRepo.SnapshotBalances"
+
+	sql := `INSERT INTO balance_snapshots (account_uuid, day, balance)
+		SELECT a.uuid, $1::date, COALESCE(prev.balance, 0) + COALESCE(delta.amount, 0)
+		FROM accounts a
+		LEFT JOIN LATERAL (
+			SELECT day, balance FROM balance_snapshots
+			WHERE account_uuid = a.uuid AND day < $1::date
+			ORDER BY day DESC LIMIT 1
+		) prev ON true
+		LEFT JOIN LATERAL (
+			SELECT SUM(amount) AS amount FROM postings
+			WHERE account_uuid = a.uuid
+			  AND created_at >= COALESCE(prev.day + 1, '-infinity'::timestamp)
+			  AND created_at < $1::date + 1
+		) delta ON true
+		ON CONFLICT (account_uuid, day) DO NOTHING;`
+
+	tag, err := b.Querier(ctx).Exec(ctx, sql, day)
+	if err != nil {
+		return 0, fmt.Errorf("%s - b.Querier.Exec: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// BalanceAt sums the latest snapshot taken before at with the postings made since.
+func (b *BankRepo) BalanceAt(ctx context.Context, accountUUID uuid.UUID, at time.Time) (int64, error) {
+	const op = "BankRepo.BalanceAt"
+
+	sql := `SELECT COALESCE(s.balance, 0) + COALESCE((
+			SELECT SUM(amount) FROM postings
+			WHERE account_uuid = a.uuid
+			  AND created_at >= COALESCE(s.day + 1, '-infinity'::timestamp)
+			  AND created_at <= $2::timestamp
+		), 0)
+		FROM accounts a
+		LEFT JOIN LATERAL (
+			SELECT day, balance FROM balance_snapshots
+			WHERE account_uuid = a.uuid AND day < $2::timestamp::date
+			ORDER BY day DESC LIMIT 1
+		) s ON true
+		WHERE a.uuid = $1;`
+
+	var balance int64
+	if err := b.ReadQuerier(ctx).QueryRow(ctx, sql, accountUUID, at).Scan(&balance); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repoerr.ErrNotFound
+		}
+		return 0, fmt.Errorf("%s - b.ReadQuerier.QueryRow: %w", op, err)
+	}
+
+	return balance, nil
+}
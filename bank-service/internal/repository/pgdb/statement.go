@@ -0,0 +1,92 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/jackc/pgx/v5"
+)
+
+// Statement computes the balances of the statement and then passes every posting
+// of its period to fn in order. Both are read from the same snapshot,
+// so the postings always add up to the closing balance.
+func (b *BankRepo) Statement(
+	ctx context.Context,
+	statement models.Statement,
+	begin func(models.Statement) error,
+	fn func(models.Posting) error,
+) error {
+	const op = "BankRepo.Statement"
+
+	tx, err := b.BeginReadTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("%s - b.BeginReadTx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql := `SELECT ` + accountBalance + `,
+			COALESCE(SUM(p.amount) FILTER (WHERE p.created_at < $2), 0),
+			COALESCE(SUM(p.amount) FILTER (WHERE p.created_at < $3), 0),
+			COALESCE(SUM(p.amount), 0)
+		FROM accounts a LEFT JOIN postings p ON p.account_uuid = a.uuid
+		WHERE a.uuid = $1
+		GROUP BY a.uuid;`
+
+	err = tx.QueryRow(ctx, sql, statement.AccountUUID, statement.From, statement.To).Scan(
+		&statement.Balance,
+		&statement.OpeningBalance,
+		&statement.ClosingBalance,
+		&statement.LedgerBalance,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repoerr.ErrNotFound
+		}
+		return fmt.Errorf("%s - tx.QueryRow: %w", op, err)
+	}
+
+	if err := begin(statement); err != nil {
+		return fmt.Errorf("%s - begin: %w", op, err)
+	}
+
+	sql = `SELECT id, account_uuid, amount, kind, transfer_uuid, batch_uuid, created_at FROM postings
+		WHERE account_uuid = $1 AND created_at >= $2 AND created_at < $3
+		ORDER BY created_at, id;`
+
+	rows, err := tx.Query(ctx, sql, statement.AccountUUID, statement.From, statement.To)
+	if err != nil {
+		return fmt.Errorf("%s - tx.Query: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var posting models.Posting
+		err := rows.Scan(
+			&posting.ID,
+			&posting.AccountUUID,
+			&posting.Amount,
+			&posting.Kind,
+			&posting.TransferUUID,
+			&posting.BatchUUID,
+			&posting.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s - rows.Scan: %w", op, err)
+		}
+
+		if err := fn(posting); err != nil {
+			return fmt.Errorf("%s - fn: %w", op, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s - rows.Err: %w", op, err)
+	}
+
+	return nil
+}
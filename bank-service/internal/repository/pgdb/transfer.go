@@ -0,0 +1,289 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const transferColumns = `uuid, from_account_uuid, to_account_uuid, amount, status, failure_reason, created_at, updated_at`
+
+type TransferRepo struct {
+	*postgres.Postgres
+}
+
+func NewTransferRepo(pg *postgres.Postgres) *TransferRepo {
+	return &TransferRepo{pg}
+}
+
+// CreateTransfer stores a pending transfer. Creating a transfer that already
+// exists with the same accounts and amount returns the stored one.
+func (t *TransferRepo) CreateTransfer(ctx context.Context, transfer models.Transfer) (models.Transfer, error) {
+	const op = "TransferRepo.CreateTransfer"
+
+	tx, err := t.Begin(ctx)
+	if err != nil {
+		return models.Transfer{}, fmt.Errorf("%s - t.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql := `INSERT INTO transfers (uuid, from_account_uuid, to_account_uuid, amount, status)
+		SELECT $1::uuid, $2::uuid, $3::uuid, $4::bigint, $5::varchar
+		WHERE EXISTS (SELECT 1 FROM accounts WHERE uuid = $2)
+		  AND EXISTS (SELECT 1 FROM accounts WHERE uuid = $3)
+		ON CONFLICT (uuid) DO NOTHING
+		RETURNING ` + transferColumns
+
+	created, err := scanTransfer(tx.QueryRow(ctx, sql,
+		transfer.UUID,
+		transfer.FromAccountUUID,
+		transfer.ToAccountUUID,
+		transfer.Amount,
+		models.TransferPending,
+	))
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return models.Transfer{}, fmt.Errorf("%s - tx.QueryRow: %w", op, err)
+		}
+
+		// Either the transfer exists already or one of the accounts does not.
+		existing, err := getTransfer(ctx, tx, transfer.UUID)
+		if err != nil {
+			if errors.Is(err, repoerr.ErrNotFound) {
+				return models.Transfer{}, repoerr.ErrNotFound
+			}
+			return models.Transfer{}, fmt.Errorf("%s - getTransfer: %w", op, err)
+		}
+
+		if existing.FromAccountUUID != transfer.FromAccountUUID ||
+			existing.ToAccountUUID != transfer.ToAccountUUID ||
+			existing.Amount != transfer.Amount {
+			return models.Transfer{}, repoerr.ErrAlreadyExist
+		}
+
+		return existing, nil
+	}
+
+	if err := insertTransferEvent(ctx, tx, created.UUID, nil, models.TransferPending, ""); err != nil {
+		return models.Transfer{}, fmt.Errorf("%s - insertTransferEvent: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Transfer{}, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	// The transfer is read back from the primary, a replica may not have it yet.
+	return t.GetTransfer(postgres.ReadYourWrites(ctx), created.UUID)
+}
+
+func (t *TransferRepo) GetTransfer(ctx context.Context, transferUUID uuid.UUID) (models.Transfer, error) {
+	const op = "TransferRepo.GetTransfer"
+
+	transfer, err := getTransfer(ctx, t.ReadQuerier(ctx), transferUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return models.Transfer{}, repoerr.ErrNotFound
+		}
+		return models.Transfer{}, fmt.Errorf("%s - getTransfer: %w", op, err)
+	}
+
+	return transfer, nil
+}
+
+func (t *TransferRepo) ListTransfers(ctx context.Context, filter models.TransferFilter) ([]models.Transfer, error) {
+	const op = "TransferRepo.ListTransfers"
+
+	sql := `SELECT ` + transferColumns + ` FROM transfers
+		WHERE ($1::uuid IS NULL OR from_account_uuid = $1 OR to_account_uuid = $1)
+		  AND ($2::text = '' OR status = $2)
+		  AND ($3::timestamp IS NULL OR (created_at, uuid) > ($3, $4))
+		ORDER BY created_at, uuid
+		LIMIT $5;`
+
+	var (
+		account     *uuid.UUID
+		afterTime   any
+		afterUUID   *uuid.UUID
+		statusParam = string(filter.Status)
+	)
+	if filter.AccountUUID != uuid.Nil {
+		account = &filter.AccountUUID
+	}
+	if filter.After != nil {
+		afterTime = filter.After.CreatedAt
+		afterUUID = &filter.After.UUID
+	}
+
+	rows, err := t.ReadQuerier(ctx).Query(ctx, sql, account, statusParam, afterTime, afterUUID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s - t.ReadQuerier.Query: %w", op, err)
+	}
+	defer rows.Close()
+
+	var transfers []models.Transfer
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s - scanTransfer: %w", op, err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s - rows.Err: %w", op, err)
+	}
+
+	return transfers, nil
+}
+
+// TransitionTransfer moves a transfer into a status that does not involve
+// money movement. Entering a status the transfer has already been in is a no-op.
+func (t *TransferRepo) TransitionTransfer(
+	ctx context.Context,
+	transferUUID uuid.UUID,
+	to models.TransferStatus,
+	reason string,
+) (models.Transfer, error) {
+	const op = "TransferRepo.TransitionTransfer"
+
+	tx, err := t.Begin(ctx)
+	if err != nil {
+		return models.Transfer{}, fmt.Errorf("%s - t.Begin: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	transfer, err := lockTransfer(ctx, tx, transferUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return models.Transfer{}, repoerr.ErrNotFound
+		}
+		return models.Transfer{}, fmt.Errorf("%s - lockTransfer: %w", op, err)
+	}
+
+	if _, err := transitionTransfer(ctx, tx, transfer, to, reason); err != nil {
+		if errors.Is(err, repoerr.ErrIllegalTransition) {
+			return models.Transfer{}, repoerr.ErrIllegalTransition
+		}
+		return models.Transfer{}, fmt.Errorf("%s - transitionTransfer: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Transfer{}, fmt.Errorf("%s - tx.Commit: %w", op, err)
+	}
+
+	return t.GetTransfer(postgres.ReadYourWrites(ctx), transferUUID)
+}
+
+func getTransfer(ctx context.Context, q postgres.Querier, transferUUID uuid.UUID) (models.Transfer, error) {
+	sql := `SELECT ` + transferColumns + ` FROM transfers WHERE uuid = $1;`
+
+	transfer, err := scanTransfer(q.QueryRow(ctx, sql, transferUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Transfer{}, repoerr.ErrNotFound
+		}
+		return models.Transfer{}, err
+	}
+
+	sql = `SELECT from_status, to_status, reason, created_at FROM transfer_events
+		WHERE transfer_uuid = $1 ORDER BY id;`
+
+	rows, err := q.Query(ctx, sql, transferUUID)
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	transfer.Events, err = pgx.CollectRows(rows, pgx.RowToStructByName[models.TransferEvent])
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func lockTransfer(ctx context.Context, tx pgx.Tx, transferUUID uuid.UUID) (models.Transfer, error) {
+	sql := `SELECT ` + transferColumns + ` FROM transfers WHERE uuid = $1 FOR UPDATE;`
+
+	transfer, err := scanTransfer(tx.QueryRow(ctx, sql, transferUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Transfer{}, repoerr.ErrNotFound
+		}
+		return models.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// transitionTransfer applies a state machine transition to a transfer locked by tx.
+// It reports false without changing anything if the transfer has already been in
+// the target status, which makes repeated requests of the same step idempotent.
+func transitionTransfer(
+	ctx context.Context,
+	tx pgx.Tx,
+	transfer models.Transfer,
+	to models.TransferStatus,
+	reason string,
+) (bool, error) {
+	var reached bool
+	sql := `SELECT EXISTS (SELECT 1 FROM transfer_events WHERE transfer_uuid = $1 AND to_status = $2);`
+	if err := tx.QueryRow(ctx, sql, transfer.UUID, to).Scan(&reached); err != nil {
+		return false, err
+	}
+	if reached {
+		return false, nil
+	}
+
+	if !transfer.Status.CanTransitionTo(to) {
+		return false, repoerr.ErrIllegalTransition
+	}
+
+	sql = `UPDATE transfers
+		SET status = $2, failure_reason = COALESCE(NULLIF($3::text, ''), failure_reason), updated_at = NOW()
+		WHERE uuid = $1;`
+	if _, err := tx.Exec(ctx, sql, transfer.UUID, to, reason); err != nil {
+		return false, err
+	}
+
+	if err := insertTransferEvent(ctx, tx, transfer.UUID, &transfer.Status, to, reason); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func insertTransferEvent(
+	ctx context.Context,
+	tx pgx.Tx,
+	transferUUID uuid.UUID,
+	from *models.TransferStatus,
+	to models.TransferStatus,
+	reason string,
+) error {
+	sql := `INSERT INTO transfer_events (transfer_uuid, from_status, to_status, reason) VALUES ($1, $2, $3, $4);`
+
+	_, err := tx.Exec(ctx, sql, transferUUID, from, to, reason)
+	return err
+}
+
+func scanTransfer(row pgx.Row) (models.Transfer, error) {
+	var transfer models.Transfer
+
+	err := row.Scan(
+		&transfer.UUID,
+		&transfer.FromAccountUUID,
+		&transfer.ToAccountUUID,
+		&transfer.Amount,
+		&transfer.Status,
+		&transfer.FailureReason,
+		&transfer.CreatedAt,
+		&transfer.UpdatedAt,
+	)
+
+	return transfer, err
+}
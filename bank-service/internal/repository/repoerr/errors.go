@@ -3,6 +3,9 @@ package repoerr
 import "errors"
 
 var (
-	ErrAlreadyExist = errors.New("already exists")
-	ErrNotFound     = errors.New("not found")
+	ErrAlreadyExist      = errors.New("already exists")
+	ErrNotFound          = errors.New("not found")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrIllegalTransition = errors.New("illegal transfer status transition")
+	ErrTransferMismatch  = errors.New("movement does not match transfer")
 )
@@ -0,0 +1,166 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/auditlog")
+
+const (
+	_defaultPageSize = 100
+	_maxPageSize     = 1000
+)
+
+type (
+	AuditProvider interface {
+		AppendAudit(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error)
+		ListAudit(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+		ScanAudit(ctx context.Context, fn func(models.AuditEntry) error) error
+	}
+
+	// AuditLog keeps an append-only, hash-chained record of state-changing calls.
+	AuditLog struct {
+		log           *slog.Logger
+		auditProvider AuditProvider
+	}
+)
+
+// errChainBroken stops the scan at the first entry breaking the chain.
+var errChainBroken = errors.New("audit chain broken")
+
+func New(
+	log *slog.Logger,
+	auditProvider AuditProvider,
+) *AuditLog {
+	return &AuditLog{
+		log:           log,
+		auditProvider: auditProvider,
+	}
+}
+
+func (a *AuditLog) Record(ctx context.Context, entry models.AuditEntry) error {
+	const op = "AuditLog.Record"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("subject", entry.Subject),
+		slog.String("method", entry.Method),
+	)
+
+	// Timestamps are stored without a time zone in UTC and with microsecond
+	// precision, the hash has to be computed over the value read back.
+	entry.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	if _, err := a.auditProvider.AppendAudit(ctx, entry); err != nil {
+		log.ErrorContext(ctx, "failed to append audit entry", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// QueryAuditLog returns a page of entries in chain order along with
+// the id to continue after, which is zero on the last page.
+func (a *AuditLog) QueryAuditLog(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, int64, error) {
+	const op = "AuditLog.QueryAuditLog"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		log.ErrorContext(ctx, "empty period", slog.Time("from", *filter.From), slog.Time("to", *filter.To))
+		return nil, 0, servicerr.InvalidField("To", "period must end after it starts")
+	}
+	if filter.From != nil {
+		from := filter.From.UTC()
+		filter.From = &from
+	}
+	if filter.To != nil {
+		to := filter.To.UTC()
+		filter.To = &to
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = _defaultPageSize
+	case filter.Limit > _maxPageSize:
+		filter.Limit = _maxPageSize
+	}
+	pageSize := filter.Limit
+
+	// One extra row tells whether there is a next page.
+	filter.Limit++
+	entries, err := a.auditProvider.ListAudit(ctx, filter)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to list audit entries", slog.Any("err", err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(entries) <= pageSize {
+		return entries, 0, nil
+	}
+
+	entries = entries[:pageSize]
+
+	return entries, entries[pageSize-1].ID, nil
+}
+
+// VerifyAuditLog recomputes the whole chain and reports the first entry
+// whose hash or link to the previous entry does not match.
+func (a *AuditLog) VerifyAuditLog(ctx context.Context) (models.AuditVerification, error) {
+	const op = "AuditLog.VerifyAuditLog"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	result := models.AuditVerification{Valid: true}
+	prev := []byte{}
+
+	err := a.auditProvider.ScanAudit(ctx, func(entry models.AuditEntry) error {
+		result.Checked++
+
+		switch {
+		case !bytes.Equal(entry.PrevHash, prev):
+			result.Reason = "previous hash does not match the previous entry"
+		case !bytes.Equal(entry.Hash, entry.ChainHash(prev)):
+			result.Reason = "hash does not match the entry"
+		default:
+			prev = entry.Hash
+			return nil
+		}
+
+		result.Valid = false
+		result.FirstInvalidID = entry.ID
+		return errChainBroken
+	})
+	if err != nil && !errors.Is(err, errChainBroken) {
+		log.ErrorContext(ctx, "failed to scan audit log", slog.Any("err", err))
+		return models.AuditVerification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !result.Valid {
+		log.WarnContext(ctx, "audit chain broken",
+			slog.Int64("id", result.FirstInvalidID),
+			slog.String("reason", result.Reason),
+		)
+	}
+
+	return result, nil
+}
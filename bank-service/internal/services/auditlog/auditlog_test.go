@@ -0,0 +1,224 @@
+package auditlog_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/memdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/auditlog"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicetest"
+)
+
+// chain serves stored entries, the tests alter them behind the back of the log.
+type chain []models.AuditEntry
+
+func (c chain) AppendAudit(context.Context, models.AuditEntry) (models.AuditEntry, error) {
+	panic("not used")
+}
+
+func (c chain) ListAudit(context.Context, models.AuditFilter) ([]models.AuditEntry, error) {
+	return c, nil
+}
+
+func (c chain) ScanAudit(_ context.Context, fn func(models.AuditEntry) error) error {
+	for _, entry := range c {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recorded returns a chain of four entries recorded by the log.
+func recorded(t *testing.T) chain {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := memdb.NewAuditRepo(memdb.NewStore())
+	a := auditlog.New(servicetest.Discard, repo)
+
+	response := `{}`
+	for _, entry := range []models.AuditEntry{
+		{Subject: "alice", Method: "CreateAccount", Request: `{"Name":"savings"}`, Code: "OK", Response: &response},
+		{Subject: "bob", Method: "Deposit", Request: `{"Amount":10}`, Code: "OK", Response: &response},
+		{Subject: "bob", Method: "Withdraw", Request: `{"Amount":99}`, Code: "FailedPrecondition", Error: "insufficient funds"},
+		{Subject: "alice", Method: "DeleteAccount", Request: `{}`, Code: "OK", Response: &response},
+	} {
+		if err := a.Record(ctx, entry); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+
+	var c chain
+	if err := repo.ScanAudit(ctx, func(entry models.AuditEntry) error {
+		c = append(c, entry)
+		return nil
+	}); err != nil {
+		t.Fatalf("ScanAudit: %v", err)
+	}
+	return c
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(c chain) chain
+		// invalid is the id of the first entry breaking the chain, zero if it holds.
+		invalid int64
+		checked int64
+	}{
+		{
+			name:    "intact",
+			tamper:  func(c chain) chain { return c },
+			checked: 4,
+		},
+		{
+			name:    "empty",
+			tamper:  func(c chain) chain { return nil },
+			checked: 0,
+		},
+		{
+			name: "altered request",
+			tamper: func(c chain) chain {
+				c[1].Request = `{"Amount":1000}`
+				return c
+			},
+			invalid: 2,
+			checked: 2,
+		},
+		{
+			name: "response removed",
+			tamper: func(c chain) chain {
+				c[0].Response = nil
+				return c
+			},
+			invalid: 1,
+			checked: 1,
+		},
+		{
+			name: "error turned into an empty response",
+			tamper: func(c chain) chain {
+				empty := ""
+				c[2].Error, c[2].Response = "", &empty
+				return c
+			},
+			invalid: 3,
+			checked: 3,
+		},
+		{
+			name: "backdated",
+			tamper: func(c chain) chain {
+				c[3].CreatedAt = c[3].CreatedAt.Add(-time.Hour)
+				return c
+			},
+			invalid: 4,
+			checked: 4,
+		},
+		{
+			name: "bytes moved between fields",
+			tamper: func(c chain) chain {
+				c[0].Subject, c[0].Method = "aliceCreate", "Account"
+				return c
+			},
+			invalid: 1,
+			checked: 1,
+		},
+		{
+			name: "altered entry rehashed",
+			tamper: func(c chain) chain {
+				c[1].Request = `{"Amount":1000}`
+				c[1].Hash = c[1].ChainHash(c[1].PrevHash)
+				return c
+			},
+			invalid: 3,
+			checked: 3,
+		},
+		{
+			name:    "entry removed",
+			tamper:  func(c chain) chain { return slices.Delete(c, 1, 2) },
+			invalid: 3,
+			checked: 2,
+		},
+		{
+			name: "entries swapped",
+			tamper: func(c chain) chain {
+				c[1], c[2] = c[2], c[1]
+				return c
+			},
+			invalid: 3,
+			checked: 2,
+		},
+		{
+			name:    "last entry removed",
+			tamper:  func(c chain) chain { return c[:3] },
+			checked: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := auditlog.New(servicetest.Discard, tt.tamper(recorded(t)))
+
+			got, err := a.VerifyAuditLog(context.Background())
+			if err != nil {
+				t.Fatalf("VerifyAuditLog: %v", err)
+			}
+
+			if got.Valid != (tt.invalid == 0) || got.FirstInvalidID != tt.invalid || got.Checked != tt.checked {
+				t.Errorf("got %+v, want first invalid %d after %d checked", got, tt.invalid, tt.checked)
+			}
+			if !got.Valid && got.Reason == "" {
+				t.Error("broken chain without a reason")
+			}
+		})
+	}
+}
+
+func TestChainHash(t *testing.T) {
+	response, empty := `{}`, ""
+	base := models.AuditEntry{
+		Subject:   "alice",
+		Method:    "Deposit",
+		Request:   `{"Amount":10}`,
+		Code:      "OK",
+		Response:  &response,
+		CreatedAt: time.Date(2024, 10, 12, 9, 30, 0, 0, time.UTC),
+	}
+	prev := base.ChainHash([]byte{})
+
+	tests := []struct {
+		name   string
+		change func(e *models.AuditEntry)
+		prev   []byte
+		same   bool
+	}{
+		{name: "same entry", change: func(e *models.AuditEntry) {}, prev: prev, same: true},
+		{name: "id and stored hashes are not hashed", change: func(e *models.AuditEntry) {
+			e.ID, e.PrevHash, e.Hash = 7, []byte("x"), []byte("y")
+		}, prev: prev, same: true},
+		{name: "other predecessor", change: func(e *models.AuditEntry) {}, prev: []byte{}},
+		{name: "subject", change: func(e *models.AuditEntry) { e.Subject = "bob" }, prev: prev},
+		{name: "method", change: func(e *models.AuditEntry) { e.Method = "Withdraw" }, prev: prev},
+		{name: "request", change: func(e *models.AuditEntry) { e.Request = `{"Amount":11}` }, prev: prev},
+		{name: "code", change: func(e *models.AuditEntry) { e.Code = "Internal" }, prev: prev},
+		{name: "error", change: func(e *models.AuditEntry) { e.Error = "boom" }, prev: prev},
+		{name: "empty response", change: func(e *models.AuditEntry) { e.Response = &empty }, prev: prev},
+		{name: "no response", change: func(e *models.AuditEntry) { e.Response = nil }, prev: prev},
+		{name: "microsecond later", change: func(e *models.AuditEntry) { e.CreatedAt = e.CreatedAt.Add(time.Microsecond) }, prev: prev},
+		{name: "field boundary", change: func(e *models.AuditEntry) { e.Subject, e.Method = "aliceDep", "osit" }, prev: prev},
+	}
+
+	want := base.ChainHash(prev)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := base
+			tt.change(&e)
+			if same := slices.Equal(e.ChainHash(tt.prev), want); same != tt.same {
+				t.Errorf("same hash: got %v, want %v", same, tt.same)
+			}
+		})
+	}
+}
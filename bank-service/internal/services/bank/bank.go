@@ -10,25 +10,40 @@ import (
 	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
 	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/bank")
+
 type (
 	AccountProvider interface {
 		CreateAccount(ctx context.Context, account models.Account) (uuid.UUID, error)
 		GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Account, error)
 		DeleteAccount(ctx context.Context, accountUUID uuid.UUID) error
+		ImportAccounts(ctx context.Context, accounts []models.AccountImport) (map[string]uuid.UUID, error)
 	}
 
+	// BalanceProvider moves money. Deposit, Withdraw and Refund report whether
+	// the money moved, a replayed step of a transfer is acknowledged without it.
 	BalanceProvider interface {
-		Deposit(ctx context.Context, details models.TransactionDetails) error
-		Withdraw(ctx context.Context, details models.TransactionDetails) error
-		Refund(ctx context.Context, details models.TransactionDetails) error
+		Deposit(ctx context.Context, details models.TransactionDetails) (bool, error)
+		Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error)
+		Refund(ctx context.Context, details models.TransactionDetails) (bool, error)
+		ApplyBatch(ctx context.Context, batch models.Batch) (models.BatchResult, error)
+	}
+
+	// TxManager runs a unit of work in one transaction, the providers called
+	// with the context passed to fn take part in it. fn may be retried after
+	// a conflict with a concurrent transaction.
+	TxManager interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
 	}
 
 	Bank struct {
 		log             *slog.Logger
 		accountProvider AccountProvider
 		balanceProvider BalanceProvider
+		txManager       TxManager
 	}
 )
 
@@ -36,31 +51,41 @@ func New(
 	log *slog.Logger,
 	accountProvider AccountProvider,
 	balanceProvider BalanceProvider,
+	txManager TxManager,
 ) *Bank {
 	return &Bank{
 		log:             log,
 		accountProvider: accountProvider,
 		balanceProvider: balanceProvider,
+		txManager:       txManager,
 	}
 }
 
 func (b *Bank) CreateAccount(ctx context.Context, account models.Account) (uuid.UUID, error) {
 	const op = "Bank.CreateAccount"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("account name", account.Name),
 	)
 	if account.Balance < 0 {
-		return uuid.Nil, servicerr.ErrInvalidArgument
+		return uuid.Nil, servicerr.InvalidField("Balance", "negative balance forbidden")
 	}
 
-	id, err := b.accountProvider.CreateAccount(ctx, account)
+	var id uuid.UUID
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = b.accountProvider.CreateAccount(ctx, account)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, repoerr.ErrAlreadyExist) {
-			log.Error("account already exist", slog.Any("err", err))
+			log.ErrorContext(ctx, "account already exist", slog.Any("err", err))
 			return uuid.Nil, servicerr.ErrAlreadyExist
 		}
-		log.Error("failed to create account", slog.Any("err", err))
+		log.ErrorContext(ctx, "failed to create account", slog.Any("err", err))
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
 	}
 	return id, nil
@@ -68,6 +93,9 @@ func (b *Bank) CreateAccount(ctx context.Context, account models.Account) (uuid.
 
 func (b *Bank) GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Account, error) {
 	const op = "Bank.GetAccount"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("accountUUID", accountUUID.String()),
@@ -76,101 +104,269 @@ func (b *Bank) GetAccount(ctx context.Context, accountUUID uuid.UUID) (models.Ac
 	account, err := b.accountProvider.GetAccount(ctx, accountUUID)
 	if err != nil {
 		if errors.Is(err, repoerr.ErrNotFound) {
-			log.Error("account not found", slog.Any("err", err))
+			log.ErrorContext(ctx, "account not found", slog.Any("err", err))
+			return models.Account{}, servicerr.ErrNotFound
 		}
-		log.Error("failed to get account", slog.Any("err", err))
+		log.ErrorContext(ctx, "failed to get account", slog.Any("err", err))
 		return models.Account{}, fmt.Errorf("%s: %w", op, err)
 	}
 	return account, nil
 }
 
 func (b *Bank) DeleteAccount(ctx context.Context, accountUUID uuid.UUID) error {
-	const op = "Bank.GetAccount"
+	const op = "Bank.DeleteAccount"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("accountUUID", accountUUID.String()),
 	)
 
-	err := b.accountProvider.DeleteAccount(ctx, accountUUID)
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		return b.accountProvider.DeleteAccount(ctx, accountUUID)
+	})
 	if err != nil {
 		if errors.Is(err, repoerr.ErrNotFound) {
-			log.Error("account not found", slog.Any("err", err))
+			log.ErrorContext(ctx, "account not found", slog.Any("err", err))
 			return servicerr.ErrNotFound
 		}
-		log.Error("failed to delete account", slog.Any("err", err))
+		log.ErrorContext(ctx, "failed to delete account", slog.Any("err", err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	return nil
 }
 
-func (b *Bank) Deposit(ctx context.Context, details models.TransactionDetails) error {
+// ImportAccounts creates the accounts that have not been imported before
+// and reports the ones already known by their external id as skipped.
+func (b *Bank) ImportAccounts(ctx context.Context, accounts []models.AccountImport) ([]models.AccountImportResult, error) {
+	const op = "Bank.ImportAccounts"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.Int("accounts", len(accounts)),
+	)
+
+	for i, account := range accounts {
+		if account.ExternalID == "" || account.Name == "" || account.Balance < 0 {
+			log.ErrorContext(ctx, "incorrect account", slog.Int("index", i))
+			return nil, servicerr.ErrInvalidArgument
+		}
+	}
+
+	var imported map[string]uuid.UUID
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		imported, err = b.accountProvider.ImportAccounts(ctx, accounts)
+		return err
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to import accounts", slog.Any("err", err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	results := make([]models.AccountImportResult, len(accounts))
+	for i, account := range accounts {
+		accountUUID, ok := imported[account.ExternalID]
+		results[i] = models.AccountImportResult{
+			ExternalID:  account.ExternalID,
+			AccountUUID: accountUUID,
+			Skipped:     !ok,
+		}
+		// A repeated external id is created once, by its first row.
+		delete(imported, account.ExternalID)
+	}
+
+	return results, nil
+}
+
+// Deposit reports whether the money moved, it did not if the step of the
+// transfer has been applied before.
+func (b *Bank) Deposit(ctx context.Context, details models.TransactionDetails) (bool, error) {
 	const op = "Bank.Deposit"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("accountUUID", details.TargetAccountUUID.String()),
+		slog.String("transferUUID", details.TransferUUID.String()),
 	)
 
 	if details.Amount <= 0 {
-		log.Error("incorrect amount")
-		return servicerr.ErrInvalidArgument
+		log.ErrorContext(ctx, "incorrect amount")
+		return false, servicerr.InvalidField("Amount", "amount must be positive")
 	}
 
-	if err := b.balanceProvider.Deposit(ctx, details); err != nil {
-		if errors.Is(err, repoerr.ErrNotFound) {
-			log.Error("account not found", slog.Any("err", err))
-			return servicerr.ErrNotFound
+	// A standalone deposit is a single posting that needs no unit of work,
+	// which leaves the provider free to batch it with concurrent ones.
+	var (
+		moved bool
+		err   error
+	)
+	if details.TransferUUID == uuid.Nil {
+		moved, err = b.balanceProvider.Deposit(ctx, details)
+	} else {
+		err = b.txManager.Do(ctx, func(ctx context.Context) error {
+			moved, err = b.balanceProvider.Deposit(ctx, details)
+			return err
+		})
+	}
+	if err != nil {
+		if serr := balanceErr(err); serr != nil {
+			log.ErrorContext(ctx, "deposit rejected", slog.Any("err", err))
+			return false, serr
 		}
-		log.Error("deposit failed", slog.Any("err", err))
-		return fmt.Errorf("%s: %w", op, err)
+		log.ErrorContext(ctx, "deposit failed", slog.Any("err", err))
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return moved, nil
 }
 
-func (b *Bank) Withdraw(ctx context.Context, details models.TransactionDetails) error {
+// Withdraw reports whether the money moved, it did not if the step of the
+// transfer has been applied before.
+func (b *Bank) Withdraw(ctx context.Context, details models.TransactionDetails) (bool, error) {
 	const op = "Bank.Withdraw"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("accountUUID", details.TargetAccountUUID.String()),
+		slog.String("transferUUID", details.TransferUUID.String()),
 	)
 
 	if details.Amount <= 0 {
-		log.Error("incorrect amount")
-		return servicerr.ErrInvalidArgument
+		log.ErrorContext(ctx, "incorrect amount")
+		return false, servicerr.InvalidField("Amount", "amount must be positive")
 	}
 
-	if err := b.balanceProvider.Withdraw(ctx, details); err != nil {
-		if errors.Is(err, repoerr.ErrNotFound) {
-			log.Error("account not found", slog.Any("err", err))
-			return servicerr.ErrNotFound
+	var moved bool
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		moved, err = b.balanceProvider.Withdraw(ctx, details)
+		return err
+	})
+	if err != nil {
+		if serr := balanceErr(err); serr != nil {
+			log.ErrorContext(ctx, "withdraw rejected", slog.Any("err", err))
+			return false, serr
 		}
-		log.Error("withdraw failed", slog.Any("err", err))
-		return fmt.Errorf("%s: %w", op, err)
+		log.ErrorContext(ctx, "withdraw failed", slog.Any("err", err))
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return moved, nil
 }
 
-func (b *Bank) Refund(ctx context.Context, details models.TransactionDetails) error {
+// Refund reports whether the money moved, it did not if the step of the
+// transfer has been applied before.
+func (b *Bank) Refund(ctx context.Context, details models.TransactionDetails) (bool, error) {
 	const op = "Bank.Refund"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("accountUUID", details.TargetAccountUUID.String()),
+		slog.String("transferUUID", details.TransferUUID.String()),
 	)
 
 	if details.Amount <= 0 {
-		log.Error("incorrect amount")
-		return servicerr.ErrInvalidArgument
+		log.ErrorContext(ctx, "incorrect amount")
+		return false, servicerr.InvalidField("Amount", "amount must be positive")
 	}
 
-	if err := b.balanceProvider.Refund(ctx, details); err != nil {
-		if errors.Is(err, repoerr.ErrNotFound) {
-			log.Error("account not found", slog.Any("err", err))
-			return servicerr.ErrNotFound
+	var moved bool
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		moved, err = b.balanceProvider.Refund(ctx, details)
+		return err
+	})
+	if err != nil {
+		if serr := balanceErr(err); serr != nil {
+			log.ErrorContext(ctx, "refund rejected", slog.Any("err", err))
+			return false, serr
 		}
-		log.Error("refund failed", slog.Any("err", err))
-		return fmt.Errorf("%s: %w", op, err)
+		log.ErrorContext(ctx, "refund failed", slog.Any("err", err))
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return moved, nil
+}
+
+// BatchPostings applies many deposits, withdrawals and transfers at once.
+// Items that cannot be applied are reported in the result rather than as an error.
+func (b *Bank) BatchPostings(ctx context.Context, batch models.Batch) (models.BatchResult, error) {
+	const op = "Bank.BatchPostings"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("mode", string(batch.Mode)),
+		slog.Int("items", len(batch.Items)),
+	)
+
+	if len(batch.Items) == 0 || len(batch.Items) > models.MaxBatchItems {
+		log.ErrorContext(ctx, "incorrect batch size")
+		return models.BatchResult{}, servicerr.InvalidField("Postings", fmt.Sprintf("batch must contain from 1 to %d postings", models.MaxBatchItems))
 	}
 
+	if batch.Mode != models.BatchAllOrNothing && batch.Mode != models.BatchBestEffort {
+		log.ErrorContext(ctx, "unknown batch mode")
+		return models.BatchResult{}, servicerr.InvalidField("Mode", "incorrect batch mode")
+	}
+
+	for i, item := range batch.Items {
+		if item.Amount <= 0 || (item.Type == models.BatchTransfer && item.FromAccountUUID == item.ToAccountUUID) {
+			log.ErrorContext(ctx, "incorrect batch item", slog.Int("index", i))
+			return models.BatchResult{}, servicerr.InvalidField(fmt.Sprintf("Postings[%d]", i), "incorrect posting")
+		}
+	}
+
+	batch.UUID = uuid.New()
+
+	var result models.BatchResult
+	err := b.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = b.balanceProvider.ApplyBatch(ctx, batch)
+		return err
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "batch failed", slog.Any("err", err))
+		return models.BatchResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range result.Items {
+		if result.Items[i].Err != nil {
+			result.Items[i].Err = balanceErr(result.Items[i].Err)
+		}
+	}
+
+	log.InfoContext(ctx, "batch applied",
+		slog.String("batchUUID", result.UUID.String()),
+		slog.Bool("committed", result.Committed),
+	)
+
+	return result, nil
+}
+
+// balanceErr translates the expected failures of a balance operation into service errors.
+// It returns nil for unexpected errors.
+func balanceErr(err error) error {
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound):
+		return servicerr.ErrNotFound
+	case errors.Is(err, repoerr.ErrInsufficientFunds):
+		return servicerr.ErrInsufficientFunds
+	case errors.Is(err, repoerr.ErrIllegalTransition):
+		return servicerr.ErrIllegalTransition
+	case errors.Is(err, repoerr.ErrTransferMismatch):
+		return servicerr.ErrTransferMismatch
+	}
 	return nil
 }
@@ -0,0 +1,199 @@
+package bank_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicetest"
+	"github.com/google/uuid"
+)
+
+func TestWithdrawInsufficientFunds(t *testing.T) {
+	f := servicetest.NewFixture(t)
+	accountUUID := f.CreateAccount(t, 100)
+
+	_, err := f.Bank.Withdraw(context.Background(), models.TransactionDetails{
+		TargetAccountUUID: accountUUID,
+		Amount:            150,
+	})
+	if !errors.Is(err, servicerr.ErrInsufficientFunds) {
+		t.Fatalf("Withdraw: got %v, want %v", err, servicerr.ErrInsufficientFunds)
+	}
+
+	if got := f.Balance(t, accountUUID); got != 100 {
+		t.Errorf("balance: got %d, want 100", got)
+	}
+}
+
+func TestAccountNotFound(t *testing.T) {
+	f := servicetest.NewFixture(t)
+	ctx := context.Background()
+	details := models.TransactionDetails{
+		TargetAccountUUID: uuid.New(),
+		Amount:            10,
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetAccount", func() error {
+			_, err := f.Bank.GetAccount(ctx, details.TargetAccountUUID)
+			return err
+		}},
+		{"DeleteAccount", func() error { return f.Bank.DeleteAccount(ctx, details.TargetAccountUUID) }},
+		{"Deposit", func() error {
+			_, err := f.Bank.Deposit(ctx, details)
+			return err
+		}},
+		{"Withdraw", func() error {
+			_, err := f.Bank.Withdraw(ctx, details)
+			return err
+		}},
+		{"Refund", func() error {
+			_, err := f.Bank.Refund(ctx, details)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, servicerr.ErrNotFound) {
+				t.Errorf("got %v, want %v", err, servicerr.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestConcurrentWithdrawals(t *testing.T) {
+	f := servicetest.NewFixture(t)
+	accountUUID := f.CreateAccount(t, 1000)
+
+	const (
+		withdrawals = 50
+		amount      = 30
+	)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for range withdrawals {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := f.Bank.Withdraw(context.Background(), models.TransactionDetails{
+				TargetAccountUUID: accountUUID,
+				Amount:            amount,
+			})
+			switch {
+			case err == nil:
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			case !errors.Is(err, servicerr.ErrInsufficientFunds):
+				t.Errorf("Withdraw: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1000/amount {
+		t.Errorf("succeeded withdrawals: got %d, want %d", succeeded, 1000/amount)
+	}
+	if got, want := f.Balance(t, accountUUID), int64(1000%amount); got != want {
+		t.Errorf("balance: got %d, want %d", got, want)
+	}
+}
+
+func TestUnitOfWorkRollback(t *testing.T) {
+	f := servicetest.NewFixture(t)
+	from := f.CreateAccount(t, 100)
+	to := f.CreateAccount(t, 0)
+
+	errAbort := errors.New("abort")
+	err := f.Store.Do(context.Background(), func(ctx context.Context) error {
+		if _, err := f.Bank.Withdraw(ctx, models.TransactionDetails{TargetAccountUUID: from, Amount: 40}); err != nil {
+			return err
+		}
+		if _, err := f.Bank.Deposit(ctx, models.TransactionDetails{TargetAccountUUID: to, Amount: 40}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("Do: got %v, want %v", err, errAbort)
+	}
+
+	if got := f.Balance(t, from); got != 100 {
+		t.Errorf("source balance: got %d, want 100", got)
+	}
+	if got := f.Balance(t, to); got != 0 {
+		t.Errorf("target balance: got %d, want 0", got)
+	}
+}
+
+func TestReplayedTransferSteps(t *testing.T) {
+	f := servicetest.NewFixture(t)
+	ctx := context.Background()
+	from := f.CreateAccount(t, 100)
+	to := f.CreateAccount(t, 0)
+
+	created, err := f.Transfers.CreateTransfer(ctx, models.Transfer{
+		FromAccountUUID: from,
+		ToAccountUUID:   to,
+		Amount:          30,
+	})
+	if err != nil {
+		t.Fatalf("CreateTransfer: %v", err)
+	}
+
+	withdraw := models.TransactionDetails{TargetAccountUUID: from, Amount: 30, TransferUUID: created.UUID}
+	deposit := models.TransactionDetails{TargetAccountUUID: to, Amount: 30, TransferUUID: created.UUID}
+	// Only the first of the replayed steps moves the money.
+	for i := range 2 {
+		moved, err := f.Bank.Withdraw(ctx, withdraw)
+		if err != nil {
+			t.Fatalf("Withdraw #%d: %v", i+1, err)
+		}
+		if moved != (i == 0) {
+			t.Errorf("Withdraw #%d: moved %t, want %t", i+1, moved, i == 0)
+		}
+	}
+	for i := range 2 {
+		moved, err := f.Bank.Deposit(ctx, deposit)
+		if err != nil {
+			t.Fatalf("Deposit #%d: %v", i+1, err)
+		}
+		if moved != (i == 0) {
+			t.Errorf("Deposit #%d: moved %t, want %t", i+1, moved, i == 0)
+		}
+	}
+
+	if got := f.Balance(t, from); got != 70 {
+		t.Errorf("source balance: got %d, want 70", got)
+	}
+	if got := f.Balance(t, to); got != 30 {
+		t.Errorf("target balance: got %d, want 30", got)
+	}
+
+	got, err := f.Transfers.GetTransfer(ctx, created.UUID)
+	if err != nil {
+		t.Fatalf("GetTransfer: %v", err)
+	}
+	if got.Status != models.TransferCompleted {
+		t.Errorf("status: got %s, want %s", got.Status, models.TransferCompleted)
+	}
+
+	// A completed transfer cannot be refunded, even by a replayed saga.
+	refund := models.TransactionDetails{TargetAccountUUID: from, Amount: 30, TransferUUID: created.UUID}
+	if _, err := f.Bank.Refund(ctx, refund); !errors.Is(err, servicerr.ErrIllegalTransition) {
+		t.Errorf("Refund: got %v, want %v", err, servicerr.ErrIllegalTransition)
+	}
+}
@@ -0,0 +1,147 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/reconciliation")
+
+type (
+	ReconciliationProvider interface {
+		CreateRun(ctx context.Context, run models.ReconciliationRun) (models.ReconciliationRun, error)
+		FinishRun(ctx context.Context, run models.ReconciliationRun) error
+		GetRun(ctx context.Context, runUUID uuid.UUID) (models.ReconciliationRun, error)
+		FindMismatches(ctx context.Context) ([]models.BalanceMismatch, int64, error)
+		RepairBalance(ctx context.Context, accountUUID uuid.UUID) error
+	}
+
+	// Reconciliation checks that account balances match the ledger.
+	// Repairing the balances is only possible if allowRepair is set.
+	Reconciliation struct {
+		log                    *slog.Logger
+		reconciliationProvider ReconciliationProvider
+		allowRepair            bool
+	}
+)
+
+func New(
+	log *slog.Logger,
+	reconciliationProvider ReconciliationProvider,
+	allowRepair bool,
+) *Reconciliation {
+	return &Reconciliation{
+		log:                    log,
+		reconciliationProvider: reconciliationProvider,
+		allowRepair:            allowRepair,
+	}
+}
+
+// Reconcile runs a reconciliation and stores its result. With repair set the
+// mismatched balances are reset to the sum of the postings of their accounts.
+func (r *Reconciliation) Reconcile(
+	ctx context.Context,
+	trigger models.ReconciliationTrigger,
+	repair bool,
+) (models.ReconciliationRun, error) {
+	const op = "Reconciliation.Reconcile"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("trigger", string(trigger)),
+		slog.Bool("repair", repair),
+	)
+
+	if repair && !r.allowRepair {
+		log.ErrorContext(ctx, "repair is not allowed")
+		return models.ReconciliationRun{}, fmt.Errorf("balance repair is disabled: %w", servicerr.ErrPermissionDenied)
+	}
+
+	run, err := r.reconciliationProvider.CreateRun(ctx, models.ReconciliationRun{
+		Trigger: trigger,
+		Repair:  repair,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to create run", slog.Any("err", err))
+		return models.ReconciliationRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("runUUID", run.UUID.String()))
+
+	run.Mismatches, run.AccountsChecked, err = r.reconciliationProvider.FindMismatches(ctx)
+	if err == nil && repair {
+		err = r.repair(ctx, log, run.Mismatches)
+	}
+
+	run.Status = models.ReconciliationCompleted
+	if err != nil {
+		log.ErrorContext(ctx, "reconciliation failed", slog.Any("err", err))
+		run.Status, run.Error = models.ReconciliationFailed, err.Error()
+	}
+
+	// The run is finished even if the request has been canceled meanwhile.
+	if err := r.reconciliationProvider.FinishRun(context.WithoutCancel(ctx), run); err != nil {
+		log.ErrorContext(ctx, "failed to finish run", slog.Any("err", err))
+		return models.ReconciliationRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(run.Mismatches) > 0 {
+		log.WarnContext(ctx, "balances do not match ledger", slog.Int("mismatches", len(run.Mismatches)))
+	}
+
+	return r.GetRun(ctx, run.UUID)
+}
+
+func (r *Reconciliation) repair(ctx context.Context, log *slog.Logger, mismatches []models.BalanceMismatch) error {
+	for i := range mismatches {
+		err := r.reconciliationProvider.RepairBalance(ctx, mismatches[i].AccountUUID)
+		if err != nil {
+			// The account has been deleted since the check.
+			if errors.Is(err, repoerr.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		mismatches[i].Repaired = true
+		log.WarnContext(ctx, "balance repaired",
+			slog.String("accountUUID", mismatches[i].AccountUUID.String()),
+			slog.Int64("balance", mismatches[i].Balance),
+			slog.Int64("ledger", mismatches[i].LedgerBalance),
+		)
+	}
+
+	return nil
+}
+
+func (r *Reconciliation) GetRun(ctx context.Context, runUUID uuid.UUID) (models.ReconciliationRun, error) {
+	const op = "Reconciliation.GetRun"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("runUUID", runUUID.String()),
+	)
+
+	run, err := r.reconciliationProvider.GetRun(ctx, runUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "run not found", slog.Any("err", err))
+			return models.ReconciliationRun{}, servicerr.ErrNotFound
+		}
+		log.ErrorContext(ctx, "failed to get run", slog.Any("err", err))
+		return models.ReconciliationRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return run, nil
+}
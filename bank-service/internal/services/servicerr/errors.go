@@ -1,9 +1,38 @@
 package servicerr
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrAlreadyExist    = errors.New("already exist")
-	ErrInvalidArgument = errors.New("invalid argument")
-	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExist      = errors.New("already exist")
+	ErrInvalidArgument   = errors.New("invalid argument")
+	ErrNotFound          = errors.New("not found")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrIllegalTransition = errors.New("illegal transfer status transition")
+	ErrTransferMismatch  = errors.New("movement does not match transfer")
+	ErrLedgerMismatch    = errors.New("ledger does not match stored balance")
+	ErrPermissionDenied  = errors.New("permission denied")
 )
+
+// FieldError is an ErrInvalidArgument naming the incorrect field of the input.
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func InvalidField(field, description string) error {
+	return &FieldError{
+		Field:       field,
+		Description: description,
+	}
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Description)
+}
+
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
@@ -0,0 +1,75 @@
+// Package servicetest runs the services on the in-memory storage for tests.
+package servicetest
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/memdb"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/bank"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/transfer"
+	"github.com/google/uuid"
+)
+
+// Discard is a logger dropping everything.
+var Discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// Fixture is a bank and its transfers sharing one in-memory store.
+type Fixture struct {
+	Store     *memdb.Store
+	Bank      *bank.Bank
+	Transfers *transfer.Transfer
+}
+
+func NewFixture(t testing.TB) Fixture {
+	t.Helper()
+
+	store := memdb.NewStore()
+	repo := memdb.New(store)
+
+	return Fixture{
+		Store:     store,
+		Bank:      bank.New(Discard, repo, repo, store),
+		Transfers: transfer.New(Discard, memdb.NewTransferRepo(store)),
+	}
+}
+
+func (f Fixture) CreateAccount(t testing.TB, balance int64) uuid.UUID {
+	t.Helper()
+
+	accountUUID, err := f.Bank.CreateAccount(context.Background(), models.Account{
+		Name:    "test",
+		Balance: balance,
+	})
+	if err != nil {
+		t.Fatalf("CreateAccount: %v", err)
+	}
+	return accountUUID
+}
+
+func (f Fixture) Balance(t testing.TB, accountUUID uuid.UUID) int64 {
+	t.Helper()
+
+	account, err := f.Bank.GetAccount(context.Background(), accountUUID)
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	return account.Balance
+}
+
+func (f Fixture) CreateTransfer(t testing.TB, from, to uuid.UUID, amount int64) models.Transfer {
+	t.Helper()
+
+	created, err := f.Transfers.CreateTransfer(context.Background(), models.Transfer{
+		FromAccountUUID: from,
+		ToAccountUUID:   to,
+		Amount:          amount,
+	})
+	if err != nil {
+		t.Fatalf("CreateTransfer: %v", err)
+	}
+	return created
+}
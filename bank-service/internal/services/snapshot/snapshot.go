@@ -0,0 +1,115 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/snapshot")
+
+const (
+	_day = 24 * time.Hour
+	// A day is snapshotted only after the transactions started
+	// before its end have had time to commit.
+	_snapshotDelay = time.Hour
+)
+
+type (
+	SnapshotProvider interface {
+		LastSnapshotDay(ctx context.Context) (*time.Time, error)
+		SnapshotBalances(ctx context.Context, day time.Time) (int64, error)
+		BalanceAt(ctx context.Context, accountUUID uuid.UUID, at time.Time) (int64, error)
+	}
+
+	// Snapshot keeps end of day balances, so historical balances
+	// are computed from the postings of a single day at most.
+	Snapshot struct {
+		log              *slog.Logger
+		snapshotProvider SnapshotProvider
+	}
+)
+
+func New(
+	log *slog.Logger,
+	snapshotProvider SnapshotProvider,
+) *Snapshot {
+	return &Snapshot{
+		log:              log,
+		snapshotProvider: snapshotProvider,
+	}
+}
+
+// SnapshotBalances snapshots every day that has ended since the last snapshot.
+// Without any snapshots it starts from the last ended day.
+func (s *Snapshot) SnapshotBalances(ctx context.Context) error {
+	const op = "Snapshot.SnapshotBalances"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	lastDay := time.Now().UTC().Add(-_snapshotDelay).Truncate(_day).Add(-_day)
+
+	day := lastDay
+	last, err := s.snapshotProvider.LastSnapshotDay(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to get last snapshot day", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if last != nil {
+		day = last.UTC().Add(_day)
+	}
+
+	for ; !day.After(lastDay); day = day.Add(_day) {
+		n, err := s.snapshotProvider.SnapshotBalances(ctx, day)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to snapshot balances", slog.Time("day", day), slog.Any("err", err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		log.InfoContext(ctx, "balances snapshotted", slog.Time("day", day), slog.Int64("accounts", n))
+	}
+
+	return nil
+}
+
+func (s *Snapshot) GetBalanceAt(ctx context.Context, accountUUID uuid.UUID, at time.Time) (models.BalanceAt, error) {
+	const op = "Snapshot.GetBalanceAt"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("accountUUID", accountUUID.String()),
+		slog.Time("at", at),
+	)
+
+	// Timestamps are stored without a time zone in UTC.
+	at = at.UTC()
+
+	balance, err := s.snapshotProvider.BalanceAt(ctx, accountUUID, at)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "account not found", slog.Any("err", err))
+			return models.BalanceAt{}, servicerr.ErrNotFound
+		}
+		log.ErrorContext(ctx, "failed to get balance", slog.Any("err", err))
+		return models.BalanceAt{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.BalanceAt{
+		AccountUUID: accountUUID,
+		At:          at,
+		Balance:     balance,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package statement
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/google/uuid"
+)
+
+// formatter renders a statement: the header with the opening balance,
+// the postings with the running balance and the footer with the closing balance.
+type formatter interface {
+	begin(statement models.Statement) error
+	posting(posting models.Posting, balance int64) error
+	end(statement models.Statement) error
+}
+
+type csvFormatter struct {
+	w *csv.Writer
+}
+
+func newCSVFormatter(w io.Writer) *csvFormatter {
+	return &csvFormatter{w: csv.NewWriter(w)}
+}
+
+func (f *csvFormatter) begin(statement models.Statement) error {
+	if err := f.w.Write([]string{"date", "kind", "amount", "balance", "posting_id", "transfer_uuid", "batch_uuid"}); err != nil {
+		return err
+	}
+
+	balance := strconv.FormatInt(statement.OpeningBalance, 10)
+	return f.w.Write([]string{formatTime(statement.From), "opening_balance", "", balance, "", "", ""})
+}
+
+func (f *csvFormatter) posting(posting models.Posting, balance int64) error {
+	return f.w.Write([]string{
+		formatTime(posting.CreatedAt),
+		string(posting.Kind),
+		strconv.FormatInt(posting.Amount, 10),
+		strconv.FormatInt(balance, 10),
+		strconv.FormatInt(posting.ID, 10),
+		formatUUID(posting.TransferUUID),
+		formatUUID(posting.BatchUUID),
+	})
+}
+
+func (f *csvFormatter) end(statement models.Statement) error {
+	balance := strconv.FormatInt(statement.ClosingBalance, 10)
+	if err := f.w.Write([]string{formatTime(statement.To), "closing_balance", "", balance, "", "", ""}); err != nil {
+		return err
+	}
+
+	f.w.Flush()
+	return f.w.Error()
+}
+
+// jsonFormatter writes a single JSON document piece by piece,
+// the postings array is left open until the statement ends.
+type jsonFormatter struct {
+	w     io.Writer
+	first bool
+}
+
+type jsonPosting struct {
+	ID           int64      `json:"posting_id"`
+	CreatedAt    string     `json:"date"`
+	Kind         string     `json:"kind"`
+	Amount       int64      `json:"amount"`
+	Balance      int64      `json:"balance"`
+	TransferUUID *uuid.UUID `json:"transfer_uuid,omitempty"`
+	BatchUUID    *uuid.UUID `json:"batch_uuid,omitempty"`
+}
+
+func newJSONFormatter(w io.Writer) *jsonFormatter {
+	return &jsonFormatter{w: w, first: true}
+}
+
+func (f *jsonFormatter) begin(statement models.Statement) error {
+	header, err := json.Marshal(struct {
+		AccountUUID    uuid.UUID `json:"account_uuid"`
+		From           string    `json:"from"`
+		To             string    `json:"to"`
+		OpeningBalance int64     `json:"opening_balance"`
+	}{
+		AccountUUID:    statement.AccountUUID,
+		From:           formatTime(statement.From),
+		To:             formatTime(statement.To),
+		OpeningBalance: statement.OpeningBalance,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Reopen the header object to append the postings to it.
+	header = append(header[:len(header)-1], `,"postings":[`...)
+	_, err = f.w.Write(header)
+	return err
+}
+
+func (f *jsonFormatter) posting(posting models.Posting, balance int64) error {
+	data, err := json.Marshal(jsonPosting{
+		ID:           posting.ID,
+		CreatedAt:    formatTime(posting.CreatedAt),
+		Kind:         string(posting.Kind),
+		Amount:       posting.Amount,
+		Balance:      balance,
+		TransferUUID: posting.TransferUUID,
+		BatchUUID:    posting.BatchUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !f.first {
+		data = append([]byte{','}, data...)
+	}
+	f.first = false
+
+	_, err = f.w.Write(data)
+	return err
+}
+
+func (f *jsonFormatter) end(statement models.Statement) error {
+	_, err := f.w.Write([]byte(`],"closing_balance":` + strconv.FormatInt(statement.ClosingBalance, 10) + "}\n"))
+	return err
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,114 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/statement")
+
+type (
+	StatementProvider interface {
+		Statement(
+			ctx context.Context,
+			statement models.Statement,
+			begin func(models.Statement) error,
+			fn func(models.Posting) error,
+		) error
+	}
+
+	// Statement writes account statements computed from the ledger.
+	Statement struct {
+		log               *slog.Logger
+		statementProvider StatementProvider
+	}
+)
+
+func New(
+	log *slog.Logger,
+	statementProvider StatementProvider,
+) *Statement {
+	return &Statement{
+		log:               log,
+		statementProvider: statementProvider,
+	}
+}
+
+// GenerateStatement writes the statement to w in the requested format while
+// the postings are read, so a statement of any size is never held in memory.
+// The statement is refused if the ledger does not add up to the stored balance.
+func (s *Statement) GenerateStatement(ctx context.Context, statement models.Statement, w io.Writer) error {
+	const op = "Statement.GenerateStatement"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("accountUUID", statement.AccountUUID.String()),
+		slog.String("format", string(statement.Format)),
+	)
+
+	if !statement.From.Before(statement.To) {
+		log.ErrorContext(ctx, "incorrect period")
+		return servicerr.InvalidField("To", "statement period must end after it starts")
+	}
+
+	var f formatter
+	switch statement.Format {
+	case models.StatementCSV:
+		f = newCSVFormatter(w)
+	case models.StatementJSON:
+		f = newJSONFormatter(w)
+	default:
+		log.ErrorContext(ctx, "unknown format")
+		return servicerr.InvalidField("Format", "incorrect statement format")
+	}
+
+	// Timestamps are stored without a time zone in UTC.
+	statement.From, statement.To = statement.From.UTC(), statement.To.UTC()
+
+	var balance int64
+	err := s.statementProvider.Statement(ctx, statement,
+		func(st models.Statement) error {
+			if st.LedgerBalance != st.Balance {
+				log.ErrorContext(ctx, "ledger does not match stored balance",
+					slog.Int64("ledger", st.LedgerBalance),
+					slog.Int64("stored", st.Balance),
+				)
+				return servicerr.ErrLedgerMismatch
+			}
+			statement, balance = st, st.OpeningBalance
+			return f.begin(st)
+		},
+		func(posting models.Posting) error {
+			balance += posting.Amount
+			return f.posting(posting, balance)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "account not found", slog.Any("err", err))
+			return servicerr.ErrNotFound
+		}
+		if errors.Is(err, servicerr.ErrLedgerMismatch) {
+			return servicerr.ErrLedgerMismatch
+		}
+		log.ErrorContext(ctx, "failed to generate statement", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := f.end(statement); err != nil {
+		log.ErrorContext(ctx, "failed to write statement", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
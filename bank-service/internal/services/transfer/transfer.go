@@ -0,0 +1,198 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/d1mitrii/money-transfer/bank-service/internal/services/transfer")
+
+const (
+	_defaultPageSize = 50
+	_maxPageSize     = 500
+)
+
+type (
+	TransferProvider interface {
+		CreateTransfer(ctx context.Context, transfer models.Transfer) (models.Transfer, error)
+		GetTransfer(ctx context.Context, transferUUID uuid.UUID) (models.Transfer, error)
+		ListTransfers(ctx context.Context, filter models.TransferFilter) ([]models.Transfer, error)
+		TransitionTransfer(ctx context.Context, transferUUID uuid.UUID, to models.TransferStatus, reason string) (models.Transfer, error)
+	}
+
+	// Transfer tracks the status of money moved between two accounts.
+	// The money itself is moved by Bank.Withdraw, Bank.Deposit and Bank.Refund
+	// called with the transfer UUID, each of them advancing the transfer.
+	Transfer struct {
+		log              *slog.Logger
+		transferProvider TransferProvider
+	}
+)
+
+func New(
+	log *slog.Logger,
+	transferProvider TransferProvider,
+) *Transfer {
+	return &Transfer{
+		log:              log,
+		transferProvider: transferProvider,
+	}
+}
+
+func (t *Transfer) CreateTransfer(ctx context.Context, transfer models.Transfer) (models.Transfer, error) {
+	const op = "Transfer.CreateTransfer"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("from", transfer.FromAccountUUID.String()),
+		slog.String("to", transfer.ToAccountUUID.String()),
+	)
+
+	if transfer.Amount <= 0 {
+		log.ErrorContext(ctx, "invalid transfer amount")
+		return models.Transfer{}, servicerr.InvalidField("Amount", "amount must be positive")
+	}
+
+	if transfer.FromAccountUUID == transfer.ToAccountUUID {
+		log.ErrorContext(ctx, "invalid transfer accounts")
+		return models.Transfer{}, servicerr.InvalidField("ToAccountUUID", "source and target accounts must differ")
+	}
+
+	if transfer.UUID == uuid.Nil {
+		transfer.UUID = uuid.New()
+	}
+
+	created, err := t.transferProvider.CreateTransfer(ctx, transfer)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "account not found", slog.Any("err", err))
+			return models.Transfer{}, servicerr.ErrNotFound
+		}
+		if errors.Is(err, repoerr.ErrAlreadyExist) {
+			log.ErrorContext(ctx, "transfer already exist", slog.Any("err", err))
+			return models.Transfer{}, servicerr.ErrAlreadyExist
+		}
+		log.ErrorContext(ctx, "failed to create transfer", slog.Any("err", err))
+		return models.Transfer{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// FailTransfer records a failed step. A pending transfer fails right away,
+// a debited one moves to compensating until its withdrawal is refunded.
+func (t *Transfer) FailTransfer(
+	ctx context.Context,
+	transferUUID uuid.UUID,
+	status models.TransferStatus,
+	reason string,
+) (models.Transfer, error) {
+	const op = "Transfer.FailTransfer"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("transferUUID", transferUUID.String()),
+		slog.String("status", string(status)),
+	)
+
+	if status != models.TransferFailed && status != models.TransferCompensating {
+		log.ErrorContext(ctx, "not a failure status")
+		return models.Transfer{}, servicerr.InvalidField("Status", "status must be failed or compensating")
+	}
+
+	transfer, err := t.transferProvider.TransitionTransfer(ctx, transferUUID, status, reason)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "transfer not found", slog.Any("err", err))
+			return models.Transfer{}, servicerr.ErrNotFound
+		}
+		if errors.Is(err, repoerr.ErrIllegalTransition) {
+			log.ErrorContext(ctx, "illegal transition", slog.Any("err", err))
+			return models.Transfer{}, servicerr.ErrIllegalTransition
+		}
+		log.ErrorContext(ctx, "failed to fail transfer", slog.Any("err", err))
+		return models.Transfer{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return transfer, nil
+}
+
+func (t *Transfer) GetTransfer(ctx context.Context, transferUUID uuid.UUID) (models.Transfer, error) {
+	const op = "Transfer.GetTransfer"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("transferUUID", transferUUID.String()),
+	)
+
+	transfer, err := t.transferProvider.GetTransfer(ctx, transferUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			log.ErrorContext(ctx, "transfer not found", slog.Any("err", err))
+			return models.Transfer{}, servicerr.ErrNotFound
+		}
+		log.ErrorContext(ctx, "failed to get transfer", slog.Any("err", err))
+		return models.Transfer{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return transfer, nil
+}
+
+// ListTransfers returns a page of transfers ordered by creation time along
+// with the cursor of the next page, which is nil on the last page.
+func (t *Transfer) ListTransfers(
+	ctx context.Context,
+	filter models.TransferFilter,
+) ([]models.Transfer, *models.TransferCursor, error) {
+	const op = "Transfer.ListTransfers"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	log := t.log.With(
+		slog.String("op", op),
+	)
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		log.ErrorContext(ctx, "unknown status", slog.String("status", string(filter.Status)))
+		return nil, nil, servicerr.InvalidField("Status", "unknown transfer status")
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = _defaultPageSize
+	case filter.Limit > _maxPageSize:
+		filter.Limit = _maxPageSize
+	}
+	pageSize := filter.Limit
+
+	// One extra row tells whether there is a next page.
+	filter.Limit++
+	transfers, err := t.transferProvider.ListTransfers(ctx, filter)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to list transfers", slog.Any("err", err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(transfers) <= pageSize {
+		return transfers, nil, nil
+	}
+
+	transfers = transfers[:pageSize]
+	last := transfers[pageSize-1]
+
+	return transfers, &models.TransferCursor{CreatedAt: last.CreatedAt, UUID: last.UUID}, nil
+}
@@ -0,0 +1,166 @@
+package transfer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/d1mitrii/money-transfer/bank-service/internal/models"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicerr"
+	"github.com/d1mitrii/money-transfer/bank-service/internal/services/servicetest"
+	"github.com/google/uuid"
+)
+
+type fixture struct {
+	servicetest.Fixture
+	from, to uuid.UUID
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+
+	f := fixture{Fixture: servicetest.NewFixture(t)}
+	f.from = f.CreateAccount(t, 100)
+	f.to = f.CreateAccount(t, 0)
+
+	return f
+}
+
+func (f fixture) createTransfer(t *testing.T) models.Transfer {
+	t.Helper()
+	return f.CreateTransfer(t, f.from, f.to, 30)
+}
+
+func TestCreateTransfer(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	created := f.createTransfer(t)
+
+	if created.Status != models.TransferPending {
+		t.Errorf("status: got %s, want %s", created.Status, models.TransferPending)
+	}
+
+	tests := []struct {
+		name     string
+		transfer models.Transfer
+		want     error
+	}{
+		{
+			name:     "replayed",
+			transfer: models.Transfer{UUID: created.UUID, FromAccountUUID: f.from, ToAccountUUID: f.to, Amount: 30},
+		},
+		{
+			name:     "replayed with other amount",
+			transfer: models.Transfer{UUID: created.UUID, FromAccountUUID: f.from, ToAccountUUID: f.to, Amount: 31},
+			want:     servicerr.ErrAlreadyExist,
+		},
+		{
+			name:     "unknown account",
+			transfer: models.Transfer{FromAccountUUID: f.from, ToAccountUUID: uuid.New(), Amount: 30},
+			want:     servicerr.ErrNotFound,
+		},
+		{
+			name:     "same accounts",
+			transfer: models.Transfer{FromAccountUUID: f.from, ToAccountUUID: f.from, Amount: 30},
+			want:     servicerr.ErrInvalidArgument,
+		},
+		{
+			name:     "zero amount",
+			transfer: models.Transfer{FromAccountUUID: f.from, ToAccountUUID: f.to},
+			want:     servicerr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := f.Transfers.CreateTransfer(ctx, tt.transfer)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if err == nil && got.UUID != created.UUID {
+				t.Errorf("uuid: got %s, want %s", got.UUID, created.UUID)
+			}
+		})
+	}
+}
+
+func TestFailTransfer(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	pending := f.createTransfer(t)
+	failed, err := f.Transfers.FailTransfer(ctx, pending.UUID, models.TransferFailed, "withdraw rejected")
+	if err != nil {
+		t.Fatalf("FailTransfer: %v", err)
+	}
+	if failed.Status != models.TransferFailed || failed.FailureReason != "withdraw rejected" {
+		t.Errorf("got %s %q, want %s %q", failed.Status, failed.FailureReason, models.TransferFailed, "withdraw rejected")
+	}
+
+	// The money of a debited transfer has left the source account, it can
+	// only be compensated.
+	debited := f.createTransfer(t)
+	if _, err := f.Bank.Withdraw(ctx, models.TransactionDetails{
+		TargetAccountUUID: f.from,
+		Amount:            debited.Amount,
+		TransferUUID:      debited.UUID,
+	}); err != nil {
+		t.Fatalf("Withdraw: %v", err)
+	}
+	if _, err := f.Transfers.FailTransfer(ctx, debited.UUID, models.TransferFailed, "deposit rejected"); !errors.Is(err, servicerr.ErrIllegalTransition) {
+		t.Errorf("FailTransfer of debited: got %v, want %v", err, servicerr.ErrIllegalTransition)
+	}
+	if _, err := f.Transfers.FailTransfer(ctx, debited.UUID, models.TransferCompensating, "deposit rejected"); err != nil {
+		t.Errorf("FailTransfer to compensating: %v", err)
+	}
+
+	if _, err := f.Transfers.FailTransfer(ctx, uuid.New(), models.TransferFailed, ""); !errors.Is(err, servicerr.ErrNotFound) {
+		t.Errorf("FailTransfer of unknown: got %v, want %v", err, servicerr.ErrNotFound)
+	}
+	if _, err := f.Transfers.FailTransfer(ctx, pending.UUID, models.TransferCompleted, ""); !errors.Is(err, servicerr.ErrInvalidArgument) {
+		t.Errorf("FailTransfer to completed: got %v, want %v", err, servicerr.ErrInvalidArgument)
+	}
+}
+
+func TestGetTransferNotFound(t *testing.T) {
+	f := newFixture(t)
+
+	if _, err := f.Transfers.GetTransfer(context.Background(), uuid.New()); !errors.Is(err, servicerr.ErrNotFound) {
+		t.Errorf("got %v, want %v", err, servicerr.ErrNotFound)
+	}
+}
+
+func TestListTransfersPages(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	want := make(map[uuid.UUID]bool)
+	for range 5 {
+		want[f.createTransfer(t).UUID] = true
+	}
+
+	filter := models.TransferFilter{AccountUUID: f.from, Limit: 2}
+	for pages := 1; ; pages++ {
+		transfers, next, err := f.Transfers.ListTransfers(ctx, filter)
+		if err != nil {
+			t.Fatalf("ListTransfers: %v", err)
+		}
+		for _, tr := range transfers {
+			if !want[tr.UUID] {
+				t.Fatalf("unexpected or repeated transfer %s", tr.UUID)
+			}
+			delete(want, tr.UUID)
+		}
+		if next == nil {
+			if pages != 3 {
+				t.Errorf("pages: got %d, want 3", pages)
+			}
+			break
+		}
+		filter.After = next
+	}
+
+	if len(want) != 0 {
+		t.Errorf("%d transfers not listed", len(want))
+	}
+}
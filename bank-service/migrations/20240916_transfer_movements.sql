@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Records the movements made for transfers, so a retried one is applied once.
+CREATE TABLE transfer_movements (
+    transfer_uuid uuid NOT NULL,
+    kind varchar(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (transfer_uuid, kind)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE transfer_movements;
+-- +goose StatementEnd
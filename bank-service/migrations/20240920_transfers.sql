@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE transfers (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    from_account_uuid uuid NOT NULL,
+    to_account_uuid uuid NOT NULL,
+    amount bigint NOT NULL CHECK (amount > 0),
+    status varchar(32) NOT NULL CHECK (status IN ('pending', 'debited', 'completed', 'compensating', 'failed', 'refunded')),
+    failure_reason text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX transfers_created_at_idx ON transfers (created_at, uuid);
+CREATE INDEX transfers_from_account_uuid_idx ON transfers (from_account_uuid, created_at);
+CREATE INDEX transfers_to_account_uuid_idx ON transfers (to_account_uuid, created_at);
+
+CREATE TABLE transfer_events (
+    id bigserial PRIMARY KEY,
+    transfer_uuid uuid NOT NULL REFERENCES transfers (uuid) ON DELETE CASCADE,
+    from_status varchar(32),
+    to_status varchar(32) NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (transfer_uuid, to_status)
+);
+
+-- transfer_movements made a movement idempotent by its (transfer_uuid, kind)
+-- key alone. Nothing tied the key to the account or the amount, so a step
+-- repeated with other details was acknowledged without moving any money, and
+-- nothing kept the steps in order: a refund could be recorded for a transfer
+-- never debited, or a deposit after its refund. The status of the transfer
+-- now decides which movement is due and the transfer row holds the accounts
+-- and the amount it is checked against, making the movements idempotent.
+DROP TABLE transfer_movements;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE transfer_movements (
+    transfer_uuid uuid NOT NULL,
+    kind varchar(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (transfer_uuid, kind)
+);
+
+DROP TABLE transfer_events;
+DROP TABLE transfers;
+-- +goose StatementEnd
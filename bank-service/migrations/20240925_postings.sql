@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE postings (
+    id bigserial PRIMARY KEY,
+    account_uuid uuid NOT NULL,
+    amount bigint NOT NULL CHECK (amount <> 0),
+    kind varchar(32) NOT NULL,
+    transfer_uuid uuid,
+    batch_uuid uuid,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX postings_account_uuid_created_at_idx ON postings (account_uuid, created_at, id);
+
+-- Existing balances become opening postings, so the ledger sums up to them.
+INSERT INTO postings (account_uuid, amount, kind, created_at)
+SELECT uuid, balance, 'opening', created_at FROM accounts WHERE balance <> 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE postings;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE accounts ADD COLUMN external_id varchar(255);
+CREATE UNIQUE INDEX accounts_external_id_idx ON accounts (external_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX accounts_external_id_idx;
+ALTER TABLE accounts DROP COLUMN external_id;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reconciliation_runs (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    trigger varchar(32) NOT NULL,
+    repair boolean NOT NULL,
+    status varchar(32) NOT NULL CHECK (status IN ('running', 'completed', 'failed')),
+    accounts_checked bigint NOT NULL DEFAULT 0,
+    mismatches jsonb NOT NULL DEFAULT '[]',
+    error text NOT NULL DEFAULT '',
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP
+);
+
+CREATE INDEX reconciliation_runs_started_at_idx ON reconciliation_runs (started_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reconciliation_runs;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A snapshot holds the balance of an account at the end of a day in UTC.
+CREATE TABLE balance_snapshots (
+    account_uuid uuid NOT NULL,
+    day date NOT NULL,
+    balance bigint NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_uuid, day)
+);
+
+CREATE INDEX balance_snapshots_day_idx ON balance_snapshots (day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE balance_snapshots;
+-- +goose StatementEnd
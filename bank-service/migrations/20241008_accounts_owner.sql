@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE accounts ADD COLUMN owner varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX accounts_owner_idx ON accounts (owner) WHERE owner <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX accounts_owner_idx;
+ALTER TABLE accounts DROP COLUMN owner;
+-- +goose StatementEnd
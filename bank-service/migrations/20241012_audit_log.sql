@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Payloads are stored as json rather than jsonb to keep the exact text the hashes are computed over.
+CREATE TABLE audit_log (
+    id bigserial PRIMARY KEY,
+    subject varchar(255) NOT NULL,
+    method varchar(255) NOT NULL,
+    request json NOT NULL,
+    code varchar(32) NOT NULL,
+    error text NOT NULL DEFAULT '',
+    response json,
+    created_at TIMESTAMP NOT NULL,
+    prev_hash bytea NOT NULL,
+    hash bytea NOT NULL
+);
+
+CREATE INDEX audit_log_subject_idx ON audit_log (subject, id);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_log;
+DROP FUNCTION audit_log_append_only();
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The balance of a sharded account is its own balance plus the balances of its
+-- shards. Credits go to a shard at random, so they do not all wait for the lock
+-- of the account row. Debits take the money of the shards back when needed.
+ALTER TABLE accounts ADD COLUMN shards int NOT NULL DEFAULT 0 CHECK (shards >= 0);
+
+CREATE TABLE balance_shards (
+    account_uuid uuid NOT NULL REFERENCES accounts (uuid) ON DELETE CASCADE,
+    shard int NOT NULL,
+    balance bigint NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    PRIMARY KEY (account_uuid, shard)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE accounts a SET balance = a.balance + s.balance
+FROM (SELECT account_uuid, SUM(balance) AS balance FROM balance_shards GROUP BY account_uuid) s
+WHERE a.uuid = s.account_uuid;
+
+DROP TABLE balance_shards;
+ALTER TABLE accounts DROP COLUMN shards;
+-- +goose StatementEnd
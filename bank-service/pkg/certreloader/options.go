@@ -0,0 +1,26 @@
+package certreloader
+
+import "time"
+
+type Option func(*Reloader)
+
+// ClientCA makes client certificates be verified against the CAs of the file.
+func ClientCA(file string) Option {
+	return func(r *Reloader) {
+		r.caFile = file
+	}
+}
+
+// Interval sets how often the files are checked for changes.
+func Interval(interval time.Duration) Option {
+	return func(r *Reloader) {
+		r.interval = interval
+	}
+}
+
+// OnError is called when changed files fail to load, the previous ones stay in use.
+func OnError(f func(error)) Option {
+	return func(r *Reloader) {
+		r.onError = f
+	}
+}
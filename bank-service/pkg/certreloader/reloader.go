@@ -0,0 +1,139 @@
+package certreloader
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+const _defaultInterval = time.Minute
+
+// Reloader serves a certificate and client CAs from files and picks up
+// their changes on the next handshake, so certificates can be rotated
+// without restarting the server.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	interval time.Duration
+	onError  func(error)
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+	checked   time.Time
+}
+
+func New(certFile, keyFile string, opts ...Option) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: _defaultInterval,
+		onError:  func(error) {},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ServerConfig returns a TLS config that serves the current files on every handshake.
+func (r *Reloader) ServerConfig(clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if clientAuth >= tls.VerifyClientCertIfGiven && r.caFile == "" {
+		return nil, errors.New("client certificates can not be verified without client CAs")
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.maybeReload()
+
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientCAs:    r.clientCAs,
+				ClientAuth:   clientAuth,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}, nil
+}
+
+func (r *Reloader) maybeReload() {
+	r.mu.RLock()
+	due := time.Since(r.checked) >= r.interval
+	r.mu.RUnlock()
+
+	if !due {
+		return
+	}
+
+	if err := r.reload(); err != nil {
+		r.onError(err)
+	}
+}
+
+// reload loads the files if any of them has changed since the last load.
+func (r *Reloader) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checked = time.Now()
+
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+
+	modTimes := make(map[string]time.Time, len(files))
+	changed := r.modTimes == nil
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("certreloader - os.Stat: %w", err)
+		}
+		modTimes[file] = info.ModTime()
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("certreloader - tls.LoadX509KeyPair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("certreloader - os.ReadFile: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("certreloader: no certificates in %s", r.caFile)
+		}
+	}
+
+	r.cert, r.clientCAs, r.modTimes = &cert, clientCAs, modTimes
+
+	return nil
+}
@@ -7,9 +7,12 @@
 package bankv1
 
 import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +24,374 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_STATUS_UNSPECIFIED  TransferStatus = 0
+	TransferStatus_TRANSFER_STATUS_PENDING      TransferStatus = 1
+	TransferStatus_TRANSFER_STATUS_DEBITED      TransferStatus = 2
+	TransferStatus_TRANSFER_STATUS_COMPLETED    TransferStatus = 3
+	TransferStatus_TRANSFER_STATUS_COMPENSATING TransferStatus = 4
+	TransferStatus_TRANSFER_STATUS_FAILED       TransferStatus = 5
+	TransferStatus_TRANSFER_STATUS_REFUNDED     TransferStatus = 6
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNSPECIFIED",
+		1: "TRANSFER_STATUS_PENDING",
+		2: "TRANSFER_STATUS_DEBITED",
+		3: "TRANSFER_STATUS_COMPLETED",
+		4: "TRANSFER_STATUS_COMPENSATING",
+		5: "TRANSFER_STATUS_FAILED",
+		6: "TRANSFER_STATUS_REFUNDED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNSPECIFIED":  0,
+		"TRANSFER_STATUS_PENDING":      1,
+		"TRANSFER_STATUS_DEBITED":      2,
+		"TRANSFER_STATUS_COMPLETED":    3,
+		"TRANSFER_STATUS_COMPENSATING": 4,
+		"TRANSFER_STATUS_FAILED":       5,
+		"TRANSFER_STATUS_REFUNDED":     6,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[0].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[0]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{0}
+}
+
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_UNSPECIFIED    BatchMode = 0
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	BatchMode_BATCH_MODE_BEST_EFFORT    BatchMode = 2
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ALL_OR_NOTHING",
+		2: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED":    0,
+		"BATCH_MODE_ALL_OR_NOTHING": 1,
+		"BATCH_MODE_BEST_EFFORT":    2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[1]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{1}
+}
+
+type PostingType int32
+
+const (
+	PostingType_POSTING_TYPE_UNSPECIFIED PostingType = 0
+	// Credits ToAccountUUID.
+	PostingType_POSTING_TYPE_DEPOSIT PostingType = 1
+	// Debits FromAccountUUID.
+	PostingType_POSTING_TYPE_WITHDRAW PostingType = 2
+	// Moves the amount from FromAccountUUID to ToAccountUUID.
+	PostingType_POSTING_TYPE_TRANSFER PostingType = 3
+)
+
+// Enum value maps for PostingType.
+var (
+	PostingType_name = map[int32]string{
+		0: "POSTING_TYPE_UNSPECIFIED",
+		1: "POSTING_TYPE_DEPOSIT",
+		2: "POSTING_TYPE_WITHDRAW",
+		3: "POSTING_TYPE_TRANSFER",
+	}
+	PostingType_value = map[string]int32{
+		"POSTING_TYPE_UNSPECIFIED": 0,
+		"POSTING_TYPE_DEPOSIT":     1,
+		"POSTING_TYPE_WITHDRAW":    2,
+		"POSTING_TYPE_TRANSFER":    3,
+	}
+)
+
+func (x PostingType) Enum() *PostingType {
+	p := new(PostingType)
+	*p = x
+	return p
+}
+
+func (x PostingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[2].Descriptor()
+}
+
+func (PostingType) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[2]
+}
+
+func (x PostingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostingType.Descriptor instead.
+func (PostingType) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{2}
+}
+
+type PostingStatus int32
+
+const (
+	PostingStatus_POSTING_STATUS_UNSPECIFIED PostingStatus = 0
+	PostingStatus_POSTING_STATUS_APPLIED     PostingStatus = 1
+	PostingStatus_POSTING_STATUS_REJECTED    PostingStatus = 2
+	// Could be applied, but was rolled back with the rest of an all-or-nothing batch.
+	PostingStatus_POSTING_STATUS_ABORTED PostingStatus = 3
+)
+
+// Enum value maps for PostingStatus.
+var (
+	PostingStatus_name = map[int32]string{
+		0: "POSTING_STATUS_UNSPECIFIED",
+		1: "POSTING_STATUS_APPLIED",
+		2: "POSTING_STATUS_REJECTED",
+		3: "POSTING_STATUS_ABORTED",
+	}
+	PostingStatus_value = map[string]int32{
+		"POSTING_STATUS_UNSPECIFIED": 0,
+		"POSTING_STATUS_APPLIED":     1,
+		"POSTING_STATUS_REJECTED":    2,
+		"POSTING_STATUS_ABORTED":     3,
+	}
+)
+
+func (x PostingStatus) Enum() *PostingStatus {
+	p := new(PostingStatus)
+	*p = x
+	return p
+}
+
+func (x PostingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[3].Descriptor()
+}
+
+func (PostingStatus) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[3]
+}
+
+func (x PostingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostingStatus.Descriptor instead.
+func (PostingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{3}
+}
+
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1
+	StatementFormat_STATEMENT_FORMAT_JSON        StatementFormat = 2
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_JSON",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_JSON":        2,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[4].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[4]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{4}
+}
+
+type ReconciliationTrigger int32
+
+const (
+	ReconciliationTrigger_RECONCILIATION_TRIGGER_UNSPECIFIED ReconciliationTrigger = 0
+	ReconciliationTrigger_RECONCILIATION_TRIGGER_MANUAL      ReconciliationTrigger = 1
+	ReconciliationTrigger_RECONCILIATION_TRIGGER_SCHEDULED   ReconciliationTrigger = 2
+)
+
+// Enum value maps for ReconciliationTrigger.
+var (
+	ReconciliationTrigger_name = map[int32]string{
+		0: "RECONCILIATION_TRIGGER_UNSPECIFIED",
+		1: "RECONCILIATION_TRIGGER_MANUAL",
+		2: "RECONCILIATION_TRIGGER_SCHEDULED",
+	}
+	ReconciliationTrigger_value = map[string]int32{
+		"RECONCILIATION_TRIGGER_UNSPECIFIED": 0,
+		"RECONCILIATION_TRIGGER_MANUAL":      1,
+		"RECONCILIATION_TRIGGER_SCHEDULED":   2,
+	}
+)
+
+func (x ReconciliationTrigger) Enum() *ReconciliationTrigger {
+	p := new(ReconciliationTrigger)
+	*p = x
+	return p
+}
+
+func (x ReconciliationTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[5].Descriptor()
+}
+
+func (ReconciliationTrigger) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[5]
+}
+
+func (x ReconciliationTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationTrigger.Descriptor instead.
+func (ReconciliationTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{5}
+}
+
+type ReconciliationStatus int32
+
+const (
+	ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED ReconciliationStatus = 0
+	ReconciliationStatus_RECONCILIATION_STATUS_RUNNING     ReconciliationStatus = 1
+	ReconciliationStatus_RECONCILIATION_STATUS_COMPLETED   ReconciliationStatus = 2
+	ReconciliationStatus_RECONCILIATION_STATUS_FAILED      ReconciliationStatus = 3
+)
+
+// Enum value maps for ReconciliationStatus.
+var (
+	ReconciliationStatus_name = map[int32]string{
+		0: "RECONCILIATION_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_STATUS_RUNNING",
+		2: "RECONCILIATION_STATUS_COMPLETED",
+		3: "RECONCILIATION_STATUS_FAILED",
+	}
+	ReconciliationStatus_value = map[string]int32{
+		"RECONCILIATION_STATUS_UNSPECIFIED": 0,
+		"RECONCILIATION_STATUS_RUNNING":     1,
+		"RECONCILIATION_STATUS_COMPLETED":   2,
+		"RECONCILIATION_STATUS_FAILED":      3,
+	}
+)
+
+func (x ReconciliationStatus) Enum() *ReconciliationStatus {
+	p := new(ReconciliationStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_bank_bank_proto_enumTypes[6].Descriptor()
+}
+
+func (ReconciliationStatus) Type() protoreflect.EnumType {
+	return &file_api_bank_bank_proto_enumTypes[6]
+}
+
+func (x ReconciliationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationStatus.Descriptor instead.
+func (ReconciliationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{6}
+}
+
 type CreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,6 +399,8 @@ type CreateAccountRequest struct {
 
 	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Balance int64  `protobuf:"varint,2,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	// Subject of the customer the account belongs to.
+	Owner string `protobuf:"bytes,3,opt,name=Owner,proto3" json:"Owner,omitempty"`
 }
 
 func (x *CreateAccountRequest) Reset() {
@@ -76,6 +449,13 @@ func (x *CreateAccountRequest) GetBalance() int64 {
 	return 0
 }
 
+func (x *CreateAccountRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,6 +558,7 @@ type GetAccountResponse struct {
 	AccountUUID string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
 	Name        string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	Balance     int64  `protobuf:"varint,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Owner       string `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
 }
 
 func (x *GetAccountResponse) Reset() {
@@ -233,6 +614,13 @@ func (x *GetAccountResponse) GetBalance() int64 {
 	return 0
 }
 
+func (x *GetAccountResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type DeleteAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -287,6 +675,8 @@ type DepositRequest struct {
 
 	AccountUUID string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
 	Amount      int64  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Optional, advances the transfer in the same database transaction as the movement.
+	TransferUUID string `protobuf:"bytes,3,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
 }
 
 func (x *DepositRequest) Reset() {
@@ -335,6 +725,13 @@ func (x *DepositRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *DepositRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
+
 type WithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -342,6 +739,8 @@ type WithdrawRequest struct {
 
 	AccountUUID string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
 	Amount      int64  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Optional, advances the transfer in the same database transaction as the movement.
+	TransferUUID string `protobuf:"bytes,3,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
 }
 
 func (x *WithdrawRequest) Reset() {
@@ -390,6 +789,13 @@ func (x *WithdrawRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *WithdrawRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
+
 type RefundRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -397,6 +803,8 @@ type RefundRequest struct {
 
 	AccountUUID string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
 	Amount      int64  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Optional, advances the transfer in the same database transaction as the movement.
+	TransferUUID string `protobuf:"bytes,3,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
 }
 
 func (x *RefundRequest) Reset() {
@@ -445,154 +853,2557 @@ func (x *RefundRequest) GetAmount() int64 {
 	return 0
 }
 
-var File_api_bank_bank_proto protoreflect.FileDescriptor
+func (x *RefundRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
 
-var file_api_bank_bank_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x39,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44,
-	0x22, 0x64, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44,
-	0x22, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x0f,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x32, 0x81, 0x03, 0x0a, 0x04, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x48, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
-	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e,
-	0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a,
-	0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x62, 0x61,
-	0x6e, 0x6b, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x6e, 0x6b, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferUUID    string                 `protobuf:"bytes,1,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
+	FromAccountUUID string                 `protobuf:"bytes,2,opt,name=FromAccountUUID,proto3" json:"FromAccountUUID,omitempty"`
+	ToAccountUUID   string                 `protobuf:"bytes,3,opt,name=ToAccountUUID,proto3" json:"ToAccountUUID,omitempty"`
+	Amount          int64                  `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Status          TransferStatus         `protobuf:"varint,5,opt,name=Status,proto3,enum=bank.TransferStatus" json:"Status,omitempty"`
+	FailureReason   string                 `protobuf:"bytes,6,opt,name=FailureReason,proto3" json:"FailureReason,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Events          []*TransferEvent       `protobuf:"bytes,9,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
-var (
-	file_api_bank_bank_proto_rawDescOnce sync.Once
-	file_api_bank_bank_proto_rawDescData = file_api_bank_bank_proto_rawDesc
-)
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_bank_bank_proto_rawDescGZIP() []byte {
-	file_api_bank_bank_proto_rawDescOnce.Do(func() {
-		file_api_bank_bank_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_bank_bank_proto_rawDescData)
-	})
-	return file_api_bank_bank_proto_rawDescData
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_bank_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_api_bank_bank_proto_goTypes = []any{
-	(*CreateAccountRequest)(nil),  // 0: bank.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 1: bank.CreateAccountResponse
-	(*GetAccountRequest)(nil),     // 2: bank.GetAccountRequest
-	(*GetAccountResponse)(nil),    // 3: bank.GetAccountResponse
-	(*DeleteAccountRequest)(nil),  // 4: bank.DeleteAccountRequest
-	(*DepositRequest)(nil),        // 5: bank.DepositRequest
-	(*WithdrawRequest)(nil),       // 6: bank.WithdrawRequest
-	(*RefundRequest)(nil),         // 7: bank.RefundRequest
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_bank_bank_proto_depIdxs = []int32{
-	0, // 0: bank.Bank.CreateAccount:input_type -> bank.CreateAccountRequest
-	2, // 1: bank.Bank.GetAccount:input_type -> bank.GetAccountRequest
-	4, // 2: bank.Bank.DeleteAccount:input_type -> bank.DeleteAccountRequest
-	5, // 3: bank.Bank.Deposit:input_type -> bank.DepositRequest
-	6, // 4: bank.Bank.Withdraw:input_type -> bank.WithdrawRequest
-	7, // 5: bank.Bank.Refund:input_type -> bank.RefundRequest
-	1, // 6: bank.Bank.CreateAccount:output_type -> bank.CreateAccountResponse
-	3, // 7: bank.Bank.GetAccount:output_type -> bank.GetAccountResponse
-	8, // 8: bank.Bank.DeleteAccount:output_type -> google.protobuf.Empty
-	8, // 9: bank.Bank.Deposit:output_type -> google.protobuf.Empty
-	8, // 10: bank.Bank.Withdraw:output_type -> google.protobuf.Empty
-	8, // 11: bank.Bank.Refund:output_type -> google.protobuf.Empty
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{8}
 }
 
-func init() { file_api_bank_bank_proto_init() }
-func file_api_bank_bank_proto_init() {
-	if File_api_bank_bank_proto != nil {
-		return
+func (x *Transfer) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_bank_bank_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAccountRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_bank_bank_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAccountResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_bank_bank_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAccountRequest); i {
-			case 0:
+	return ""
+}
+
+func (x *Transfer) GetFromAccountUUID() string {
+	if x != nil {
+		return x.FromAccountUUID
+	}
+	return ""
+}
+
+func (x *Transfer) GetToAccountUUID() string {
+	if x != nil {
+		return x.ToAccountUUID
+	}
+	return ""
+}
+
+func (x *Transfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transfer) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *Transfer) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetEvents() []*TransferEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TransferEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromStatus TransferStatus         `protobuf:"varint,1,opt,name=FromStatus,proto3,enum=bank.TransferStatus" json:"FromStatus,omitempty"`
+	ToStatus   TransferStatus         `protobuf:"varint,2,opt,name=ToStatus,proto3,enum=bank.TransferStatus" json:"ToStatus,omitempty"`
+	Reason     string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *TransferEvent) Reset() {
+	*x = TransferEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransferEvent) GetFromStatus() TransferStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *TransferEvent) GetToStatus() TransferStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *TransferEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional, a repeated request with the same TransferUUID returns the existing transfer.
+	TransferUUID    string `protobuf:"bytes,1,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
+	FromAccountUUID string `protobuf:"bytes,2,opt,name=FromAccountUUID,proto3" json:"FromAccountUUID,omitempty"`
+	ToAccountUUID   string `protobuf:"bytes,3,opt,name=ToAccountUUID,proto3" json:"ToAccountUUID,omitempty"`
+	Amount          int64  `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateTransferRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetFromAccountUUID() string {
+	if x != nil {
+		return x.FromAccountUUID
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetToAccountUUID() string {
+	if x != nil {
+		return x.ToAccountUUID
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type FailTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferUUID string `protobuf:"bytes,1,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
+	// TRANSFER_STATUS_FAILED or TRANSFER_STATUS_COMPENSATING.
+	Status TransferStatus `protobuf:"varint,2,opt,name=Status,proto3,enum=bank.TransferStatus" json:"Status,omitempty"`
+	Reason string         `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *FailTransferRequest) Reset() {
+	*x = FailTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailTransferRequest) ProtoMessage() {}
+
+func (x *FailTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailTransferRequest.ProtoReflect.Descriptor instead.
+func (*FailTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FailTransferRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
+
+func (x *FailTransferRequest) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *FailTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferUUID string `protobuf:"bytes,1,opt,name=TransferUUID,proto3" json:"TransferUUID,omitempty"`
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTransferRequest) GetTransferUUID() string {
+	if x != nil {
+		return x.TransferUUID
+	}
+	return ""
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional, matches transfers from or to the account.
+	AccountUUID string         `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
+	Status      TransferStatus `protobuf:"varint,2,opt,name=Status,proto3,enum=bank.TransferStatus" json:"Status,omitempty"`
+	PageSize    int32          `protobuf:"varint,3,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken   string         `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTransfersRequest) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *ListTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers     []*Transfer `protobuf:"bytes,1,rep,name=Transfers,proto3" json:"Transfers,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchPosting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type            PostingType `protobuf:"varint,1,opt,name=Type,proto3,enum=bank.PostingType" json:"Type,omitempty"`
+	FromAccountUUID string      `protobuf:"bytes,2,opt,name=FromAccountUUID,proto3" json:"FromAccountUUID,omitempty"`
+	ToAccountUUID   string      `protobuf:"bytes,3,opt,name=ToAccountUUID,proto3" json:"ToAccountUUID,omitempty"`
+	Amount          int64       `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *BatchPosting) Reset() {
+	*x = BatchPosting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPosting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPosting) ProtoMessage() {}
+
+func (x *BatchPosting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPosting.ProtoReflect.Descriptor instead.
+func (*BatchPosting) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchPosting) GetType() PostingType {
+	if x != nil {
+		return x.Type
+	}
+	return PostingType_POSTING_TYPE_UNSPECIFIED
+}
+
+func (x *BatchPosting) GetFromAccountUUID() string {
+	if x != nil {
+		return x.FromAccountUUID
+	}
+	return ""
+}
+
+func (x *BatchPosting) GetToAccountUUID() string {
+	if x != nil {
+		return x.ToAccountUUID
+	}
+	return ""
+}
+
+func (x *BatchPosting) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BatchPostingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  int32         `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Status PostingStatus `protobuf:"varint,2,opt,name=Status,proto3,enum=bank.PostingStatus" json:"Status,omitempty"`
+	Error  string        `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Reason of the ErrorInfo the posting would fail with on its own.
+	Reason string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *BatchPostingResult) Reset() {
+	*x = BatchPostingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPostingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPostingResult) ProtoMessage() {}
+
+func (x *BatchPostingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPostingResult.ProtoReflect.Descriptor instead.
+func (*BatchPostingResult) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchPostingResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchPostingResult) GetStatus() PostingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostingStatus_POSTING_STATUS_UNSPECIFIED
+}
+
+func (x *BatchPostingResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchPostingResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BatchPostingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode     BatchMode       `protobuf:"varint,1,opt,name=Mode,proto3,enum=bank.BatchMode" json:"Mode,omitempty"`
+	Postings []*BatchPosting `protobuf:"bytes,2,rep,name=Postings,proto3" json:"Postings,omitempty"`
+}
+
+func (x *BatchPostingsRequest) Reset() {
+	*x = BatchPostingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPostingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPostingsRequest) ProtoMessage() {}
+
+func (x *BatchPostingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPostingsRequest.ProtoReflect.Descriptor instead.
+func (*BatchPostingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchPostingsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+func (x *BatchPostingsRequest) GetPostings() []*BatchPosting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+type BatchPostingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchUUID string                `protobuf:"bytes,1,opt,name=BatchUUID,proto3" json:"BatchUUID,omitempty"`
+	Committed bool                  `protobuf:"varint,2,opt,name=Committed,proto3" json:"Committed,omitempty"`
+	Results   []*BatchPostingResult `protobuf:"bytes,3,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *BatchPostingsResponse) Reset() {
+	*x = BatchPostingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPostingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPostingsResponse) ProtoMessage() {}
+
+func (x *BatchPostingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPostingsResponse.ProtoReflect.Descriptor instead.
+func (*BatchPostingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchPostingsResponse) GetBatchUUID() string {
+	if x != nil {
+		return x.BatchUUID
+	}
+	return ""
+}
+
+func (x *BatchPostingsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BatchPostingsResponse) GetResults() []*BatchPostingResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ImportAccountRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the account in the system it is migrated from.
+	ExternalID string `protobuf:"bytes,1,opt,name=ExternalID,proto3" json:"ExternalID,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Balance    int64  `protobuf:"varint,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Owner      string `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
+}
+
+func (x *ImportAccountRow) Reset() {
+	*x = ImportAccountRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountRow) ProtoMessage() {}
+
+func (x *ImportAccountRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountRow.ProtoReflect.Descriptor instead.
+func (*ImportAccountRow) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportAccountRow) GetExternalID() string {
+	if x != nil {
+		return x.ExternalID
+	}
+	return ""
+}
+
+func (x *ImportAccountRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportAccountRow) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ImportAccountRow) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type ImportAccountError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the row in the stream, starting from 0.
+	Row        int64  `protobuf:"varint,1,opt,name=Row,proto3" json:"Row,omitempty"`
+	ExternalID string `protobuf:"bytes,2,opt,name=ExternalID,proto3" json:"ExternalID,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// Incorrect field of the row.
+	Field string `protobuf:"bytes,5,opt,name=Field,proto3" json:"Field,omitempty"`
+}
+
+func (x *ImportAccountError) Reset() {
+	*x = ImportAccountError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountError) ProtoMessage() {}
+
+func (x *ImportAccountError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountError.ProtoReflect.Descriptor instead.
+func (*ImportAccountError) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportAccountError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportAccountError) GetExternalID() string {
+	if x != nil {
+		return x.ExternalID
+	}
+	return ""
+}
+
+func (x *ImportAccountError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportAccountError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportAccountError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type ImportAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int64 `protobuf:"varint,1,opt,name=Imported,proto3" json:"Imported,omitempty"`
+	// Rows whose ExternalID had already been imported.
+	Skipped int64 `protobuf:"varint,2,opt,name=Skipped,proto3" json:"Skipped,omitempty"`
+	Failed  int64 `protobuf:"varint,3,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	// Errors of the first failed rows, the rest are only counted in Failed.
+	Errors []*ImportAccountError `protobuf:"bytes,4,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	// ExternalID of the last row stored, resending the rows after it resumes the import.
+	LastExternalID string `protobuf:"bytes,5,opt,name=LastExternalID,proto3" json:"LastExternalID,omitempty"`
+}
+
+func (x *ImportAccountsResponse) Reset() {
+	*x = ImportAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountsResponse) ProtoMessage() {}
+
+func (x *ImportAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ImportAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportAccountsResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportAccountsResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportAccountsResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportAccountsResponse) GetErrors() []*ImportAccountError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportAccountsResponse) GetLastExternalID() string {
+	if x != nil {
+		return x.LastExternalID
+	}
+	return ""
+}
+
+type GenerateStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountUUID string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
+	// The statement covers the postings made from From inclusive to To exclusive.
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	Format StatementFormat        `protobuf:"varint,4,opt,name=Format,proto3,enum=bank.StatementFormat" json:"Format,omitempty"`
+}
+
+func (x *GenerateStatementRequest) Reset() {
+	*x = GenerateStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementRequest) ProtoMessage() {}
+
+func (x *GenerateStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementRequest.ProtoReflect.Descriptor instead.
+func (*GenerateStatementRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GenerateStatementRequest) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *GenerateStatementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GenerateStatementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GenerateStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+type StatementChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Concatenated chunks make up the statement document.
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *StatementChunk) Reset() {
+	*x = StatementChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementChunk) ProtoMessage() {}
+
+func (x *StatementChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementChunk.ProtoReflect.Descriptor instead.
+func (*StatementChunk) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StatementChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReconcileBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resets mismatched balances to the ledger ones, if the service allows repairs.
+	Repair bool `protobuf:"varint,1,opt,name=Repair,proto3" json:"Repair,omitempty"`
+}
+
+func (x *ReconcileBalancesRequest) Reset() {
+	*x = ReconcileBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileBalancesRequest) ProtoMessage() {}
+
+func (x *ReconcileBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReconcileBalancesRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type GetReconciliationRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunUUID string `protobuf:"bytes,1,opt,name=RunUUID,proto3" json:"RunUUID,omitempty"`
+}
+
+func (x *GetReconciliationRunRequest) Reset() {
+	*x = GetReconciliationRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReconciliationRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationRunRequest) ProtoMessage() {}
+
+func (x *GetReconciliationRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationRunRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationRunRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReconciliationRunRequest) GetRunUUID() string {
+	if x != nil {
+		return x.RunUUID
+	}
+	return ""
+}
+
+type BalanceMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountUUID   string `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
+	Balance       int64  `protobuf:"varint,2,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	LedgerBalance int64  `protobuf:"varint,3,opt,name=LedgerBalance,proto3" json:"LedgerBalance,omitempty"`
+	Repaired      bool   `protobuf:"varint,4,opt,name=Repaired,proto3" json:"Repaired,omitempty"`
+}
+
+func (x *BalanceMismatch) Reset() {
+	*x = BalanceMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceMismatch) ProtoMessage() {}
+
+func (x *BalanceMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceMismatch.ProtoReflect.Descriptor instead.
+func (*BalanceMismatch) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BalanceMismatch) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *BalanceMismatch) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *BalanceMismatch) GetLedgerBalance() int64 {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return 0
+}
+
+func (x *BalanceMismatch) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type ReconciliationRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunUUID         string                 `protobuf:"bytes,1,opt,name=RunUUID,proto3" json:"RunUUID,omitempty"`
+	Trigger         ReconciliationTrigger  `protobuf:"varint,2,opt,name=Trigger,proto3,enum=bank.ReconciliationTrigger" json:"Trigger,omitempty"`
+	Status          ReconciliationStatus   `protobuf:"varint,3,opt,name=Status,proto3,enum=bank.ReconciliationStatus" json:"Status,omitempty"`
+	Repair          bool                   `protobuf:"varint,4,opt,name=Repair,proto3" json:"Repair,omitempty"`
+	AccountsChecked int64                  `protobuf:"varint,5,opt,name=AccountsChecked,proto3" json:"AccountsChecked,omitempty"`
+	Mismatches      []*BalanceMismatch     `protobuf:"bytes,6,rep,name=Mismatches,proto3" json:"Mismatches,omitempty"`
+	Error           string                 `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=FinishedAt,proto3" json:"FinishedAt,omitempty"`
+}
+
+func (x *ReconciliationRun) Reset() {
+	*x = ReconciliationRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRun) ProtoMessage() {}
+
+func (x *ReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRun.ProtoReflect.Descriptor instead.
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReconciliationRun) GetRunUUID() string {
+	if x != nil {
+		return x.RunUUID
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetTrigger() ReconciliationTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return ReconciliationTrigger_RECONCILIATION_TRIGGER_UNSPECIFIED
+}
+
+func (x *ReconciliationRun) GetStatus() ReconciliationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED
+}
+
+func (x *ReconciliationRun) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *ReconciliationRun) GetAccountsChecked() int64 {
+	if x != nil {
+		return x.AccountsChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetMismatches() []*BalanceMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type GetBalanceAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountUUID string                 `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
+	At          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`
+}
+
+func (x *GetBalanceAtRequest) Reset() {
+	*x = GetBalanceAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtRequest) ProtoMessage() {}
+
+func (x *GetBalanceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBalanceAtRequest) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *GetBalanceAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetBalanceAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountUUID string                 `protobuf:"bytes,1,opt,name=AccountUUID,proto3" json:"AccountUUID,omitempty"`
+	At          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`
+	Balance     int64                  `protobuf:"varint,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+}
+
+func (x *GetBalanceAtResponse) Reset() {
+	*x = GetBalanceAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtResponse) ProtoMessage() {}
+
+func (x *GetBalanceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBalanceAtResponse) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *GetBalanceAtResponse) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GetBalanceAtResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	// Full gRPC method name, e.g. /bank.Bank/Deposit.
+	Method string `protobuf:"bytes,3,opt,name=Method,proto3" json:"Method,omitempty"`
+	// Request and response payloads as JSON.
+	Request   string                 `protobuf:"bytes,4,opt,name=Request,proto3" json:"Request,omitempty"`
+	Code      string                 `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error     string                 `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	Response  string                 `protobuf:"bytes,7,opt,name=Response,proto3" json:"Response,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// Hex encoded SHA-256 of the previous entry and of this one.
+	PrevHash string `protobuf:"bytes,9,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
+	Hash     string `protobuf:"bytes,10,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AuditEntry) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject   string                 `protobuf:"bytes,1,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	Method    string                 `protobuf:"bytes,2,opt,name=Method,proto3" json:"Method,omitempty"`
+	From      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	PageSize  int32                  `protobuf:"varint,5,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken string                 `protobuf:"bytes,6,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryAuditLogRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*AuditEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryAuditLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VerifyAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid   bool  `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Checked int64 `protobuf:"varint,2,opt,name=Checked,proto3" json:"Checked,omitempty"`
+	// The first entry breaking the chain, if any.
+	FirstInvalidID int64  `protobuf:"varint,3,opt,name=FirstInvalidID,proto3" json:"FirstInvalidID,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bank_bank_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bank_bank_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_bank_bank_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetFirstInvalidID() int64 {
+	if x != nil {
+		return x.FirstInvalidID
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_api_bank_bank_proto protoreflect.FileDescriptor
+
+var file_api_bank_bank_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x35,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x7a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x38, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x6e, 0x0a, 0x0e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x6f, 0x0a, 0x0f, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x6d, 0x0a, 0x0d,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x8b, 0x03, 0x0a, 0x08,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f,
+	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x54,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x06,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62,
+	0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x46,
+	0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x30, 0x0a, 0x08, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x54, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a,
+	0x0d, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x13, 0x46,
+	0x61, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
+	0x44, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6b,
+	0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x50,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x15,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x8a, 0x01,
+	0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4c,
+	0x61, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x22, 0xc7, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x2d, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x32, 0x0a,
+	0x18, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x22, 0x37, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x9d, 0x03, 0x0a,
+	0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x63, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x41,
+	0x74, 0x22, 0x7e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x02, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x98, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0xde, 0x01, 0x0a,
+	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x2a, 0xe6, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1c,
+	0x0a, 0x18, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x62, 0x0a, 0x09,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x02,
+	0x2a, 0x7b, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x53, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x84, 0x01,
+	0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x4f, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x53, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0x68, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x88,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x43, 0x4f,
+	0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xa7, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f,
+	0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0xd3, 0x0e, 0x0a, 0x04, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x61, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01,
+	0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x55, 0x49, 0x44, 0x7d, 0x12, 0x67, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x12, 0x66, 0x0a,
+	0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01,
+	0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x3a, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x69, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x3a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x12, 0x63, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e,
+	0x6b, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a,
+	0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x3a, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x57, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x67,
+	0x0a, 0x0c, 0x46, 0x61, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x19,
+	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x55, 0x55, 0x49,
+	0x44, 0x7d, 0x3a, 0x66, 0x61, 0x69, 0x6c, 0x12, 0x5d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x12, 0x5f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x0e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x77, 0x1a, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x28, 0x01, 0x12, 0x79, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
+	0x44, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x6c,
+	0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x79, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6e, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x52,
+	0x75, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x7d, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x12, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55, 0x49,
+	0x44, 0x7d, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x60, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x3a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0xf8, 0x01, 0x92, 0x41, 0xdf, 0x01,
+	0x12, 0x58, 0x0a, 0x08, 0x42, 0x61, 0x6e, 0x6b, 0x20, 0x41, 0x50, 0x49, 0x12, 0x47, 0x48, 0x54,
+	0x54, 0x50, 0x2f, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x6e, 0x6b, 0x20, 0x67, 0x52, 0x50, 0x43,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x20, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x20, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x2e, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x5a, 0x5c, 0x0a,
+	0x1d, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0f, 0x08,
+	0x02, 0x1a, 0x09, 0x58, 0x2d, 0x41, 0x70, 0x69, 0x2d, 0x4b, 0x65, 0x79, 0x20, 0x02, 0x0a, 0x3b,
+	0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2d, 0x08, 0x02,
+	0x12, 0x18, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x73, 0x20, 0x27, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x27, 0x2e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a,
+	0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x62, 0x10, 0x0a,
+	0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x5a,
+	0x13, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61,
+	0x6e, 0x6b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_bank_bank_proto_rawDescOnce sync.Once
+	file_api_bank_bank_proto_rawDescData = file_api_bank_bank_proto_rawDesc
+)
+
+func file_api_bank_bank_proto_rawDescGZIP() []byte {
+	file_api_bank_bank_proto_rawDescOnce.Do(func() {
+		file_api_bank_bank_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_bank_bank_proto_rawDescData)
+	})
+	return file_api_bank_bank_proto_rawDescData
+}
+
+var file_api_bank_bank_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_bank_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_api_bank_bank_proto_goTypes = []any{
+	(TransferStatus)(0),                 // 0: bank.TransferStatus
+	(BatchMode)(0),                      // 1: bank.BatchMode
+	(PostingType)(0),                    // 2: bank.PostingType
+	(PostingStatus)(0),                  // 3: bank.PostingStatus
+	(StatementFormat)(0),                // 4: bank.StatementFormat
+	(ReconciliationTrigger)(0),          // 5: bank.ReconciliationTrigger
+	(ReconciliationStatus)(0),           // 6: bank.ReconciliationStatus
+	(*CreateAccountRequest)(nil),        // 7: bank.CreateAccountRequest
+	(*CreateAccountResponse)(nil),       // 8: bank.CreateAccountResponse
+	(*GetAccountRequest)(nil),           // 9: bank.GetAccountRequest
+	(*GetAccountResponse)(nil),          // 10: bank.GetAccountResponse
+	(*DeleteAccountRequest)(nil),        // 11: bank.DeleteAccountRequest
+	(*DepositRequest)(nil),              // 12: bank.DepositRequest
+	(*WithdrawRequest)(nil),             // 13: bank.WithdrawRequest
+	(*RefundRequest)(nil),               // 14: bank.RefundRequest
+	(*Transfer)(nil),                    // 15: bank.Transfer
+	(*TransferEvent)(nil),               // 16: bank.TransferEvent
+	(*CreateTransferRequest)(nil),       // 17: bank.CreateTransferRequest
+	(*FailTransferRequest)(nil),         // 18: bank.FailTransferRequest
+	(*GetTransferRequest)(nil),          // 19: bank.GetTransferRequest
+	(*ListTransfersRequest)(nil),        // 20: bank.ListTransfersRequest
+	(*ListTransfersResponse)(nil),       // 21: bank.ListTransfersResponse
+	(*BatchPosting)(nil),                // 22: bank.BatchPosting
+	(*BatchPostingResult)(nil),          // 23: bank.BatchPostingResult
+	(*BatchPostingsRequest)(nil),        // 24: bank.BatchPostingsRequest
+	(*BatchPostingsResponse)(nil),       // 25: bank.BatchPostingsResponse
+	(*ImportAccountRow)(nil),            // 26: bank.ImportAccountRow
+	(*ImportAccountError)(nil),          // 27: bank.ImportAccountError
+	(*ImportAccountsResponse)(nil),      // 28: bank.ImportAccountsResponse
+	(*GenerateStatementRequest)(nil),    // 29: bank.GenerateStatementRequest
+	(*StatementChunk)(nil),              // 30: bank.StatementChunk
+	(*ReconcileBalancesRequest)(nil),    // 31: bank.ReconcileBalancesRequest
+	(*GetReconciliationRunRequest)(nil), // 32: bank.GetReconciliationRunRequest
+	(*BalanceMismatch)(nil),             // 33: bank.BalanceMismatch
+	(*ReconciliationRun)(nil),           // 34: bank.ReconciliationRun
+	(*GetBalanceAtRequest)(nil),         // 35: bank.GetBalanceAtRequest
+	(*GetBalanceAtResponse)(nil),        // 36: bank.GetBalanceAtResponse
+	(*AuditEntry)(nil),                  // 37: bank.AuditEntry
+	(*QueryAuditLogRequest)(nil),        // 38: bank.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),       // 39: bank.QueryAuditLogResponse
+	(*VerifyAuditLogResponse)(nil),      // 40: bank.VerifyAuditLogResponse
+	(*timestamppb.Timestamp)(nil),       // 41: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 42: google.protobuf.Empty
+}
+var file_api_bank_bank_proto_depIdxs = []int32{
+	0,  // 0: bank.Transfer.Status:type_name -> bank.TransferStatus
+	41, // 1: bank.Transfer.CreatedAt:type_name -> google.protobuf.Timestamp
+	41, // 2: bank.Transfer.UpdatedAt:type_name -> google.protobuf.Timestamp
+	16, // 3: bank.Transfer.Events:type_name -> bank.TransferEvent
+	0,  // 4: bank.TransferEvent.FromStatus:type_name -> bank.TransferStatus
+	0,  // 5: bank.TransferEvent.ToStatus:type_name -> bank.TransferStatus
+	41, // 6: bank.TransferEvent.CreatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 7: bank.FailTransferRequest.Status:type_name -> bank.TransferStatus
+	0,  // 8: bank.ListTransfersRequest.Status:type_name -> bank.TransferStatus
+	15, // 9: bank.ListTransfersResponse.Transfers:type_name -> bank.Transfer
+	2,  // 10: bank.BatchPosting.Type:type_name -> bank.PostingType
+	3,  // 11: bank.BatchPostingResult.Status:type_name -> bank.PostingStatus
+	1,  // 12: bank.BatchPostingsRequest.Mode:type_name -> bank.BatchMode
+	22, // 13: bank.BatchPostingsRequest.Postings:type_name -> bank.BatchPosting
+	23, // 14: bank.BatchPostingsResponse.Results:type_name -> bank.BatchPostingResult
+	27, // 15: bank.ImportAccountsResponse.Errors:type_name -> bank.ImportAccountError
+	41, // 16: bank.GenerateStatementRequest.From:type_name -> google.protobuf.Timestamp
+	41, // 17: bank.GenerateStatementRequest.To:type_name -> google.protobuf.Timestamp
+	4,  // 18: bank.GenerateStatementRequest.Format:type_name -> bank.StatementFormat
+	5,  // 19: bank.ReconciliationRun.Trigger:type_name -> bank.ReconciliationTrigger
+	6,  // 20: bank.ReconciliationRun.Status:type_name -> bank.ReconciliationStatus
+	33, // 21: bank.ReconciliationRun.Mismatches:type_name -> bank.BalanceMismatch
+	41, // 22: bank.ReconciliationRun.StartedAt:type_name -> google.protobuf.Timestamp
+	41, // 23: bank.ReconciliationRun.FinishedAt:type_name -> google.protobuf.Timestamp
+	41, // 24: bank.GetBalanceAtRequest.At:type_name -> google.protobuf.Timestamp
+	41, // 25: bank.GetBalanceAtResponse.At:type_name -> google.protobuf.Timestamp
+	41, // 26: bank.AuditEntry.CreatedAt:type_name -> google.protobuf.Timestamp
+	41, // 27: bank.QueryAuditLogRequest.From:type_name -> google.protobuf.Timestamp
+	41, // 28: bank.QueryAuditLogRequest.To:type_name -> google.protobuf.Timestamp
+	37, // 29: bank.QueryAuditLogResponse.Entries:type_name -> bank.AuditEntry
+	7,  // 30: bank.Bank.CreateAccount:input_type -> bank.CreateAccountRequest
+	9,  // 31: bank.Bank.GetAccount:input_type -> bank.GetAccountRequest
+	11, // 32: bank.Bank.DeleteAccount:input_type -> bank.DeleteAccountRequest
+	12, // 33: bank.Bank.Deposit:input_type -> bank.DepositRequest
+	13, // 34: bank.Bank.Withdraw:input_type -> bank.WithdrawRequest
+	14, // 35: bank.Bank.Refund:input_type -> bank.RefundRequest
+	17, // 36: bank.Bank.CreateTransfer:input_type -> bank.CreateTransferRequest
+	18, // 37: bank.Bank.FailTransfer:input_type -> bank.FailTransferRequest
+	19, // 38: bank.Bank.GetTransfer:input_type -> bank.GetTransferRequest
+	20, // 39: bank.Bank.ListTransfers:input_type -> bank.ListTransfersRequest
+	24, // 40: bank.Bank.BatchPostings:input_type -> bank.BatchPostingsRequest
+	26, // 41: bank.Bank.ImportAccounts:input_type -> bank.ImportAccountRow
+	29, // 42: bank.Bank.GenerateStatement:input_type -> bank.GenerateStatementRequest
+	31, // 43: bank.Bank.ReconcileBalances:input_type -> bank.ReconcileBalancesRequest
+	32, // 44: bank.Bank.GetReconciliationRun:input_type -> bank.GetReconciliationRunRequest
+	35, // 45: bank.Bank.GetBalanceAt:input_type -> bank.GetBalanceAtRequest
+	38, // 46: bank.Bank.QueryAuditLog:input_type -> bank.QueryAuditLogRequest
+	42, // 47: bank.Bank.VerifyAuditLog:input_type -> google.protobuf.Empty
+	8,  // 48: bank.Bank.CreateAccount:output_type -> bank.CreateAccountResponse
+	10, // 49: bank.Bank.GetAccount:output_type -> bank.GetAccountResponse
+	42, // 50: bank.Bank.DeleteAccount:output_type -> google.protobuf.Empty
+	42, // 51: bank.Bank.Deposit:output_type -> google.protobuf.Empty
+	42, // 52: bank.Bank.Withdraw:output_type -> google.protobuf.Empty
+	42, // 53: bank.Bank.Refund:output_type -> google.protobuf.Empty
+	15, // 54: bank.Bank.CreateTransfer:output_type -> bank.Transfer
+	15, // 55: bank.Bank.FailTransfer:output_type -> bank.Transfer
+	15, // 56: bank.Bank.GetTransfer:output_type -> bank.Transfer
+	21, // 57: bank.Bank.ListTransfers:output_type -> bank.ListTransfersResponse
+	25, // 58: bank.Bank.BatchPostings:output_type -> bank.BatchPostingsResponse
+	28, // 59: bank.Bank.ImportAccounts:output_type -> bank.ImportAccountsResponse
+	30, // 60: bank.Bank.GenerateStatement:output_type -> bank.StatementChunk
+	34, // 61: bank.Bank.ReconcileBalances:output_type -> bank.ReconciliationRun
+	34, // 62: bank.Bank.GetReconciliationRun:output_type -> bank.ReconciliationRun
+	36, // 63: bank.Bank.GetBalanceAt:output_type -> bank.GetBalanceAtResponse
+	39, // 64: bank.Bank.QueryAuditLog:output_type -> bank.QueryAuditLogResponse
+	40, // 65: bank.Bank.VerifyAuditLog:output_type -> bank.VerifyAuditLogResponse
+	48, // [48:66] is the sub-list for method output_type
+	30, // [30:48] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_api_bank_bank_proto_init() }
+func file_api_bank_bank_proto_init() {
+	if File_api_bank_bank_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_bank_bank_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAccountRequest); i {
+			case 0:
 				return &v.state
 			case 1:
 				return &v.sizeCache
@@ -602,8 +3413,8 @@ func file_api_bank_bank_proto_init() {
 				return nil
 			}
 		}
-		file_api_bank_bank_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAccountResponse); i {
+		file_api_bank_bank_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -614,8 +3425,8 @@ func file_api_bank_bank_proto_init() {
 				return nil
 			}
 		}
-		file_api_bank_bank_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteAccountRequest); i {
+		file_api_bank_bank_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*WithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -626,8 +3437,8 @@ func file_api_bank_bank_proto_init() {
 				return nil
 			}
 		}
-		file_api_bank_bank_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DepositRequest); i {
+		file_api_bank_bank_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RefundRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -638,8 +3449,8 @@ func file_api_bank_bank_proto_init() {
 				return nil
 			}
 		}
-		file_api_bank_bank_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*WithdrawRequest); i {
+		file_api_bank_bank_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Transfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -650,8 +3461,296 @@ func file_api_bank_bank_proto_init() {
 				return nil
 			}
 		}
-		file_api_bank_bank_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*RefundRequest); i {
+		file_api_bank_bank_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*FailTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTransfersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchPosting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchPostingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchPostingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchPostingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportAccountRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportAccountError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*StatementChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReconciliationRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*BalanceMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconciliationRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBalanceAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBalanceAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bank_bank_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAuditLogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -668,13 +3767,14 @@ func file_api_bank_bank_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_bank_bank_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      7,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_bank_bank_proto_goTypes,
 		DependencyIndexes: file_api_bank_bank_proto_depIdxs,
+		EnumInfos:         file_api_bank_bank_proto_enumTypes,
 		MessageInfos:      file_api_bank_bank_proto_msgTypes,
 	}.Build()
 	File_api_bank_bank_proto = out.File
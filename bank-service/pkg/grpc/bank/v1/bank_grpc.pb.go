@@ -20,12 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Bank_CreateAccount_FullMethodName = "/bank.Bank/CreateAccount"
-	Bank_GetAccount_FullMethodName    = "/bank.Bank/GetAccount"
-	Bank_DeleteAccount_FullMethodName = "/bank.Bank/DeleteAccount"
-	Bank_Deposit_FullMethodName       = "/bank.Bank/Deposit"
-	Bank_Withdraw_FullMethodName      = "/bank.Bank/Withdraw"
-	Bank_Refund_FullMethodName        = "/bank.Bank/Refund"
+	Bank_CreateAccount_FullMethodName        = "/bank.Bank/CreateAccount"
+	Bank_GetAccount_FullMethodName           = "/bank.Bank/GetAccount"
+	Bank_DeleteAccount_FullMethodName        = "/bank.Bank/DeleteAccount"
+	Bank_Deposit_FullMethodName              = "/bank.Bank/Deposit"
+	Bank_Withdraw_FullMethodName             = "/bank.Bank/Withdraw"
+	Bank_Refund_FullMethodName               = "/bank.Bank/Refund"
+	Bank_CreateTransfer_FullMethodName       = "/bank.Bank/CreateTransfer"
+	Bank_FailTransfer_FullMethodName         = "/bank.Bank/FailTransfer"
+	Bank_GetTransfer_FullMethodName          = "/bank.Bank/GetTransfer"
+	Bank_ListTransfers_FullMethodName        = "/bank.Bank/ListTransfers"
+	Bank_BatchPostings_FullMethodName        = "/bank.Bank/BatchPostings"
+	Bank_ImportAccounts_FullMethodName       = "/bank.Bank/ImportAccounts"
+	Bank_GenerateStatement_FullMethodName    = "/bank.Bank/GenerateStatement"
+	Bank_ReconcileBalances_FullMethodName    = "/bank.Bank/ReconcileBalances"
+	Bank_GetReconciliationRun_FullMethodName = "/bank.Bank/GetReconciliationRun"
+	Bank_GetBalanceAt_FullMethodName         = "/bank.Bank/GetBalanceAt"
+	Bank_QueryAuditLog_FullMethodName        = "/bank.Bank/QueryAuditLog"
+	Bank_VerifyAuditLog_FullMethodName       = "/bank.Bank/VerifyAuditLog"
 )
 
 // BankClient is the client API for Bank service.
@@ -38,6 +50,23 @@ type BankClient interface {
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Refund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	FailTransfer(ctx context.Context, in *FailTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	BatchPostings(ctx context.Context, in *BatchPostingsRequest, opts ...grpc.CallOption) (*BatchPostingsResponse, error)
+	// Creates accounts from a stream of rows. Rows whose ExternalID has been
+	// imported before are skipped, so an interrupted import can be resent.
+	ImportAccounts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportAccountRow, ImportAccountsResponse], error)
+	// Streams the statement of an account as chunks of a CSV or JSON document.
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatementChunk], error)
+	// Compares every account balance with the sum of its postings.
+	ReconcileBalances(ctx context.Context, in *ReconcileBalancesRequest, opts ...grpc.CallOption) (*ReconciliationRun, error)
+	GetReconciliationRun(ctx context.Context, in *GetReconciliationRunRequest, opts ...grpc.CallOption) (*ReconciliationRun, error)
+	// Returns the balance of an account including every posting made up to At.
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
 }
 
 type bankClient struct {
@@ -108,6 +137,138 @@ func (c *bankClient) Refund(ctx context.Context, in *RefundRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *bankClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Bank_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) FailTransfer(ctx context.Context, in *FailTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Bank_FailTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Bank_GetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, Bank_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) BatchPostings(ctx context.Context, in *BatchPostingsRequest, opts ...grpc.CallOption) (*BatchPostingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchPostingsResponse)
+	err := c.cc.Invoke(ctx, Bank_BatchPostings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) ImportAccounts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportAccountRow, ImportAccountsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bank_ServiceDesc.Streams[0], Bank_ImportAccounts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportAccountRow, ImportAccountsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bank_ImportAccountsClient = grpc.ClientStreamingClient[ImportAccountRow, ImportAccountsResponse]
+
+func (c *bankClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatementChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bank_ServiceDesc.Streams[1], Bank_GenerateStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateStatementRequest, StatementChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bank_GenerateStatementClient = grpc.ServerStreamingClient[StatementChunk]
+
+func (c *bankClient) ReconcileBalances(ctx context.Context, in *ReconcileBalancesRequest, opts ...grpc.CallOption) (*ReconciliationRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationRun)
+	err := c.cc.Invoke(ctx, Bank_ReconcileBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) GetReconciliationRun(ctx context.Context, in *GetReconciliationRunRequest, opts ...grpc.CallOption) (*ReconciliationRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationRun)
+	err := c.cc.Invoke(ctx, Bank_GetReconciliationRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceAtResponse)
+	err := c.cc.Invoke(ctx, Bank_GetBalanceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, Bank_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankClient) VerifyAuditLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, Bank_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankServer is the server API for Bank service.
 // All implementations must embed UnimplementedBankServer
 // for forward compatibility.
@@ -118,6 +279,23 @@ type BankServer interface {
 	Deposit(context.Context, *DepositRequest) (*emptypb.Empty, error)
 	Withdraw(context.Context, *WithdrawRequest) (*emptypb.Empty, error)
 	Refund(context.Context, *RefundRequest) (*emptypb.Empty, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error)
+	FailTransfer(context.Context, *FailTransferRequest) (*Transfer, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*Transfer, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	BatchPostings(context.Context, *BatchPostingsRequest) (*BatchPostingsResponse, error)
+	// Creates accounts from a stream of rows. Rows whose ExternalID has been
+	// imported before are skipped, so an interrupted import can be resent.
+	ImportAccounts(grpc.ClientStreamingServer[ImportAccountRow, ImportAccountsResponse]) error
+	// Streams the statement of an account as chunks of a CSV or JSON document.
+	GenerateStatement(*GenerateStatementRequest, grpc.ServerStreamingServer[StatementChunk]) error
+	// Compares every account balance with the sum of its postings.
+	ReconcileBalances(context.Context, *ReconcileBalancesRequest) (*ReconciliationRun, error)
+	GetReconciliationRun(context.Context, *GetReconciliationRunRequest) (*ReconciliationRun, error)
+	// Returns the balance of an account including every posting made up to At.
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	VerifyAuditLog(context.Context, *emptypb.Empty) (*VerifyAuditLogResponse, error)
 	mustEmbedUnimplementedBankServer()
 }
 
@@ -146,6 +324,42 @@ func (UnimplementedBankServer) Withdraw(context.Context, *WithdrawRequest) (*emp
 func (UnimplementedBankServer) Refund(context.Context, *RefundRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
 }
+func (UnimplementedBankServer) CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedBankServer) FailTransfer(context.Context, *FailTransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailTransfer not implemented")
+}
+func (UnimplementedBankServer) GetTransfer(context.Context, *GetTransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedBankServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBankServer) BatchPostings(context.Context, *BatchPostingsRequest) (*BatchPostingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPostings not implemented")
+}
+func (UnimplementedBankServer) ImportAccounts(grpc.ClientStreamingServer[ImportAccountRow, ImportAccountsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportAccounts not implemented")
+}
+func (UnimplementedBankServer) GenerateStatement(*GenerateStatementRequest, grpc.ServerStreamingServer[StatementChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedBankServer) ReconcileBalances(context.Context, *ReconcileBalancesRequest) (*ReconciliationRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileBalances not implemented")
+}
+func (UnimplementedBankServer) GetReconciliationRun(context.Context, *GetReconciliationRunRequest) (*ReconciliationRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationRun not implemented")
+}
+func (UnimplementedBankServer) GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedBankServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedBankServer) VerifyAuditLog(context.Context, *emptypb.Empty) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
 func (UnimplementedBankServer) mustEmbedUnimplementedBankServer() {}
 func (UnimplementedBankServer) testEmbeddedByValue()              {}
 
@@ -275,6 +489,204 @@ func _Bank_Refund_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bank_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_FailTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).FailTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_FailTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).FailTransfer(ctx, req.(*FailTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_GetTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_BatchPostings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPostingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).BatchPostings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_BatchPostings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).BatchPostings(ctx, req.(*BatchPostingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_ImportAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BankServer).ImportAccounts(&grpc.GenericServerStream[ImportAccountRow, ImportAccountsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bank_ImportAccountsServer = grpc.ClientStreamingServer[ImportAccountRow, ImportAccountsResponse]
+
+func _Bank_GenerateStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BankServer).GenerateStatement(m, &grpc.GenericServerStream[GenerateStatementRequest, StatementChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bank_GenerateStatementServer = grpc.ServerStreamingServer[StatementChunk]
+
+func _Bank_ReconcileBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).ReconcileBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_ReconcileBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).ReconcileBalances(ctx, req.(*ReconcileBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_GetReconciliationRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).GetReconciliationRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_GetReconciliationRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).GetReconciliationRun(ctx, req.(*GetReconciliationRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_GetBalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bank_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bank_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServer).VerifyAuditLog(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bank_ServiceDesc is the grpc.ServiceDesc for Bank service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,7 +718,58 @@ var Bank_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Refund",
 			Handler:    _Bank_Refund_Handler,
 		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _Bank_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "FailTransfer",
+			Handler:    _Bank_FailTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _Bank_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _Bank_ListTransfers_Handler,
+		},
+		{
+			MethodName: "BatchPostings",
+			Handler:    _Bank_BatchPostings_Handler,
+		},
+		{
+			MethodName: "ReconcileBalances",
+			Handler:    _Bank_ReconcileBalances_Handler,
+		},
+		{
+			MethodName: "GetReconciliationRun",
+			Handler:    _Bank_GetReconciliationRun_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _Bank_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Bank_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _Bank_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportAccounts",
+			Handler:       _Bank_ImportAccounts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GenerateStatement",
+			Handler:       _Bank_GenerateStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/bank/bank.proto",
 }
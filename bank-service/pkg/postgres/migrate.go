@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// Migrator applies the goose migrations found in a file system. Every change
+// of the schema holds a session-level advisory lock, so replicas migrating
+// at once wait for each other and apply every migration once.
+type Migrator struct {
+	db       *sql.DB
+	provider *goose.Provider
+}
+
+func NewMigrator(pg *Postgres, fsys fs.FS) (*Migrator, error) {
+	// The lock holds a connection while the migrations run on another one.
+	if pg.Pool.Config().MaxConns < 2 {
+		return nil, errors.New("postgres - NewMigrator: migrations need a pool of at least 2 connections")
+	}
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("postgres - NewMigrator - lock.NewPostgresSessionLocker: %w", err)
+	}
+
+	db := stdlib.OpenDBFromPool(pg.Pool)
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, fsys,
+		goose.WithSessionLocker(locker),
+		goose.WithDisableGlobalRegistry(true),
+	)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("postgres - NewMigrator - goose.NewProvider: %w", err)
+	}
+
+	return &Migrator{
+		db:       db,
+		provider: provider,
+	}, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+// Down rolls back the last applied migration.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx)
+}
+
+// To migrates up or down to the version, zero rolls back every migration.
+func (m *Migrator) To(ctx context.Context, version int64) ([]*goose.MigrationResult, error) {
+	current, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case version > current:
+		return m.provider.UpTo(ctx, version)
+	case version < current:
+		return m.provider.DownTo(ctx, version)
+	}
+	return nil, nil
+}
+
+// Status lists every migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Close releases the connections of the migrator, the pool stays open.
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
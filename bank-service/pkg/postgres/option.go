@@ -1,6 +1,10 @@
 package postgres
 
-import "time"
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
 
 type Option func(*Postgres)
 
@@ -21,3 +25,62 @@ func ConnTimeout(timeout time.Duration) Option {
 		c.connTimeout = timeout
 	}
 }
+
+// StatementTimeout makes the server cancel statements running longer than timeout.
+func StatementTimeout(timeout time.Duration) Option {
+	return func(c *Postgres) {
+		c.statementTimeout = timeout
+	}
+}
+
+// Tracer is called around the queries of every connection of the pool.
+func Tracer(tracer pgx.QueryTracer) Option {
+	return func(c *Postgres) {
+		c.tracer = tracer
+	}
+}
+
+// Replicas adds pools of the replicas at the urls, which serve the reads of
+// ReadQuerier and BeginReadTx.
+func Replicas(urls ...string) Option {
+	return func(c *Postgres) {
+		c.replicaURLs = urls
+	}
+}
+
+// MaxReplicaLag is how far behind the primary a replica may be to serve reads, a second by default.
+func MaxReplicaLag(lag time.Duration) Option {
+	return func(c *Postgres) {
+		c.maxReplicaLag = lag
+	}
+}
+
+// ReplicaCheckInterval is how often the lag of the replicas is measured, a second by default.
+func ReplicaCheckInterval(interval time.Duration) Option {
+	return func(c *Postgres) {
+		c.replicaCheckInterval = interval
+	}
+}
+
+type TxOption func(*TxManager)
+
+// IsoLevel is the isolation level of the transactions, read committed by default.
+func IsoLevel(level pgx.TxIsoLevel) TxOption {
+	return func(m *TxManager) {
+		m.isoLevel = level
+	}
+}
+
+// MaxRetries limits how many times a unit of work is retried, zero disables retries.
+func MaxRetries(retries int) TxOption {
+	return func(m *TxManager) {
+		m.maxRetries = retries
+	}
+}
+
+// RetryDelay is the delay before the first retry, it doubles with every next one.
+func RetryDelay(delay time.Duration) TxOption {
+	return func(m *TxManager) {
+		m.retryDelay = delay
+	}
+}
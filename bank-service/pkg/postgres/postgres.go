@@ -2,10 +2,17 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"strconv"
+	"sync/atomic"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgconn/ctxwatch"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -13,14 +20,30 @@ const (
 	_defaultMaxPoolSize  = 1
 	_defaultConnAttempts = 10
 	_defaultConnTimeout  = time.Second
+
+	// How long a query keeps running after its context is done, waiting for
+	// the server to cancel it, before the connection is closed.
+	_cancelDeadlineDelay = time.Second
 )
 
 type Postgres struct {
 	maxPoolSize  int
 	connAttempts int
 	connTimeout  time.Duration
+	tracer       pgx.QueryTracer
+
+	statementTimeout time.Duration
+
+	replicaURLs          []string
+	maxReplicaLag        time.Duration
+	replicaCheckInterval time.Duration
 
 	Pool *pgxpool.Pool
+
+	replicas    []*replica
+	nextReplica atomic.Uint64
+	stopWatch   chan struct{}
+	watchDone   chan struct{}
 }
 
 func New(url string, opts ...Option) (*Postgres, error) {
@@ -28,19 +51,20 @@ func New(url string, opts ...Option) (*Postgres, error) {
 		maxPoolSize:  _defaultMaxPoolSize,
 		connAttempts: _defaultConnAttempts,
 		connTimeout:  _defaultConnTimeout,
+
+		maxReplicaLag:        _defaultMaxReplicaLag,
+		replicaCheckInterval: _defaultReplicaCheckInterval,
 	}
 
 	for _, opt := range opts {
 		opt(pg)
 	}
 
-	poolConfig, err := pgxpool.ParseConfig(url)
+	poolConfig, err := pg.poolConfig(url)
 	if err != nil {
 		return nil, fmt.Errorf("postgres - New - pgxpool.ParseConfig: %w", err)
 	}
 
-	poolConfig.MaxConns = int32(pg.maxPoolSize)
-
 	for pg.connAttempts > 0 {
 		pg.Pool, err = pgxpool.NewWithConfig(context.Background(), poolConfig)
 		if err == nil {
@@ -58,10 +82,82 @@ func New(url string, opts ...Option) (*Postgres, error) {
 		return nil, fmt.Errorf("postgres - New - connAttempts == 0: %w", err)
 	}
 
+	if len(pg.replicaURLs) > 0 && pg.replicaCheckInterval <= 0 {
+		pg.Close()
+		return nil, errors.New("postgres - New: replica check interval must be positive")
+	}
+
+	// Replicas serve no reads until their lag is measured, so the service
+	// starts even if they are down.
+	for _, replicaURL := range pg.replicaURLs {
+		replicaConfig, err := pg.poolConfig(replicaURL)
+		if err != nil {
+			pg.Close()
+			return nil, fmt.Errorf("postgres - New - replica - pgxpool.ParseConfig: %w", err)
+		}
+
+		pool, err := pgxpool.NewWithConfig(context.Background(), replicaConfig)
+		if err != nil {
+			pg.Close()
+			return nil, fmt.Errorf("postgres - New - replica - pgxpool.NewWithConfig: %w", err)
+		}
+
+		r := &replica{
+			addr: net.JoinHostPort(replicaConfig.ConnConfig.Host, strconv.Itoa(int(replicaConfig.ConnConfig.Port))),
+			pool: pool,
+		}
+		r.lag.Store(_lagUnknown)
+		pg.replicas = append(pg.replicas, r)
+	}
+
+	if len(pg.replicas) > 0 {
+		pg.stopWatch = make(chan struct{})
+		pg.watchDone = make(chan struct{})
+		go pg.watchReplicas()
+	}
+
 	return pg, nil
 }
 
+// poolConfig parses the url into the config of a pool with the options applied.
+func (p *Postgres) poolConfig(url string) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, err
+	}
+
+	poolConfig.MaxConns = int32(p.maxPoolSize)
+	poolConfig.ConnConfig.Tracer = p.tracer
+
+	// Timestamps are stored without a time zone, in UTC. The session zone
+	// makes NOW() and the casts of the queries agree with that whatever the
+	// zone of the server is.
+	poolConfig.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+	if p.statementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(p.statementTimeout.Milliseconds(), 10)
+	}
+
+	// By default a done context only closes the connection on the client side,
+	// the server would keep running the query. Cancel it on the server instead.
+	poolConfig.ConnConfig.BuildContextWatcherHandler = func(conn *pgconn.PgConn) ctxwatch.Handler {
+		return &pgconn.CancelRequestContextWatcherHandler{
+			Conn:          conn,
+			DeadlineDelay: _cancelDeadlineDelay,
+		}
+	}
+
+	return poolConfig, nil
+}
+
 func (p *Postgres) Close() {
+	if p.stopWatch != nil {
+		close(p.stopWatch)
+		<-p.watchDone
+	}
+	for _, r := range p.replicas {
+		r.pool.Close()
+	}
 	if p.Pool != nil {
 		p.Pool.Close()
 	}
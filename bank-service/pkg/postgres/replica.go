@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	_defaultMaxReplicaLag        = time.Second
+	_defaultReplicaCheckInterval = time.Second
+
+	// _lagUnknown marks a replica whose lag has not been measured yet
+	// or could not be measured the last time.
+	_lagUnknown = -1
+)
+
+// _replicaLagSQL measures how far behind the primary the replica is. A replica
+// streaming from the primary that has replayed everything it received is not
+// behind, even if the primary has written nothing for a while. A replica whose
+// WAL receiver is not streaming is behind by an unknown amount, which counts as
+// infinitely, seeing it requires pg_read_all_stats. A server that is not in
+// recovery is not a replica at all, so it is never behind.
+const _replicaLagSQL = `
+SELECT CASE
+    WHEN NOT pg_is_in_recovery() THEN 0
+    WHEN NOT EXISTS (SELECT 1 FROM pg_stat_wal_receiver WHERE status = 'streaming') THEN 'Infinity'::float8
+    WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+    ELSE coalesce(extract(epoch FROM now() - pg_last_xact_replay_timestamp()), 'Infinity')
+END::float8;`
+
+type readYourWritesKey struct{}
+
+// ReadYourWrites marks ctx so that the reads made with it go to the primary,
+// which has every write committed before.
+func ReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+func readsYourWrites(ctx context.Context) bool {
+	ryw, _ := ctx.Value(readYourWritesKey{}).(bool)
+	return ryw
+}
+
+type replica struct {
+	addr string
+	pool *pgxpool.Pool
+	// lag is the last measured lag in nanoseconds, or _lagUnknown.
+	lag atomic.Int64
+}
+
+// ReadQuerier returns a querier for reads that may be behind the primary by up
+// to the max replica lag. It is a replica within that lag, or the primary if
+// there is none, ctx reads its writes or runs within a transaction.
+func (p *Postgres) ReadQuerier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return p.readPool(ctx)
+}
+
+// BeginReadTx is BeginTx for reads, the transaction is started where
+// ReadQuerier would run the reads. It should be read only.
+func (p *Postgres) BeginReadTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return p.readPool(ctx).BeginTx(ctx, opts)
+}
+
+// readPool picks the replicas in turn, skipping the ones behind by more than the max lag.
+func (p *Postgres) readPool(ctx context.Context) *pgxpool.Pool {
+	if len(p.replicas) == 0 || readsYourWrites(ctx) {
+		return p.Pool
+	}
+
+	n := uint64(len(p.replicas))
+	start := p.nextReplica.Add(1)
+	for i := range n {
+		r := p.replicas[(start+i)%n]
+		if lag := r.lag.Load(); lag != _lagUnknown && lag <= int64(p.maxReplicaLag) {
+			return r.pool
+		}
+	}
+
+	return p.Pool
+}
+
+// watchReplicas measures the lag of the replicas until Close is called.
+func (p *Postgres) watchReplicas() {
+	defer close(p.watchDone)
+
+	ticker := time.NewTicker(p.replicaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, r := range p.replicas {
+			p.checkReplica(r)
+		}
+
+		select {
+		case <-p.stopWatch:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Postgres) checkReplica(r *replica) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.replicaCheckInterval)
+	defer cancel()
+
+	lag := int64(_lagUnknown)
+
+	var seconds float64
+	err := r.pool.QueryRow(ctx, _replicaLagSQL).Scan(&seconds)
+	switch {
+	case err != nil:
+		if r.lag.Load() != _lagUnknown {
+			log.Printf("Postgres replica %s is unreachable, reading from the others: %v", r.addr, err)
+		}
+	case seconds*float64(time.Second) >= math.MaxInt64:
+		lag = math.MaxInt64
+	default:
+		lag = int64(seconds * float64(time.Second))
+	}
+
+	prev := r.lag.Swap(lag)
+	maxLag := int64(p.maxReplicaLag)
+	switch {
+	case lag == math.MaxInt64 && prev != math.MaxInt64:
+		log.Printf("Postgres replica %s is not streaming from the primary, reading from the others", r.addr)
+	case lag != _lagUnknown && lag > maxLag && (prev == _lagUnknown || prev <= maxLag):
+		log.Printf("Postgres replica %s is behind by %s, reading from the others", r.addr, time.Duration(lag))
+	case lag != _lagUnknown && lag <= maxLag && (prev == _lagUnknown || prev > maxLag):
+		log.Printf("Postgres replica %s is serving reads", r.addr)
+	}
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	_defaultTxMaxRetries = 3
+	_defaultTxRetryDelay = 10 * time.Millisecond
+)
+
+type (
+	txKey       struct{}
+	conflictKey struct{}
+	commitKey   struct{}
+)
+
+// Querier is satisfied by both the pool and a transaction.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// Querier returns the transaction run by a TxManager for ctx, or the pool outside of one.
+func (p *Postgres) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return p.Pool
+}
+
+// Begin starts a transaction, or a savepoint within the transaction of ctx.
+func (p *Postgres) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return p.Pool.Begin(ctx)
+}
+
+// BeginTx starts a transaction with the options. Within the transaction of ctx
+// it starts a savepoint instead, which keeps the options of that transaction.
+func (p *Postgres) BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return p.Pool.BeginTx(ctx, opts)
+}
+
+// TxManager runs units of work in a transaction. The transaction is passed
+// through the context, so the repositories called with it take part in it.
+// Units of work failing on a serialization failure or a deadlock are retried.
+type TxManager struct {
+	pg         *Postgres
+	isoLevel   pgx.TxIsoLevel
+	maxRetries int
+	retryDelay time.Duration
+}
+
+func NewTxManager(pg *Postgres, opts ...TxOption) *TxManager {
+	m := &TxManager{
+		pg:         pg,
+		isoLevel:   pgx.ReadCommitted,
+		maxRetries: _defaultTxMaxRetries,
+		retryDelay: _defaultTxRetryDelay,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Do runs fn in a transaction and commits it if fn succeeds. Called within
+// a transaction already, fn joins it, and the transaction is retried if fn
+// fails on a conflict, whatever error the outer unit of work ends with.
+// fn may run several times, so it must not have effects outside of the database.
+func (m *TxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		err := fn(ctx)
+		if conflict, ok := ctx.Value(conflictKey{}).(*atomic.Bool); ok && retryable(err) {
+			conflict.Store(true)
+		}
+		return err
+	}
+
+	delay := m.retryDelay
+	for attempt := 0; ; attempt++ {
+		conflict, err := m.do(ctx, fn)
+		if err == nil || attempt >= m.maxRetries || !(conflict || retryable(err)) {
+			return err
+		}
+
+		// Jitter keeps the retries of conflicting transactions apart.
+		wait := delay
+		if delay > 0 {
+			wait = delay/2 + rand.N(delay)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// do runs fn in a transaction, it reports whether a unit of work joining it
+// failed on a conflict.
+func (m *TxManager) do(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	tx, err := m.pg.Pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: m.isoLevel})
+	if err != nil {
+		return false, fmt.Errorf("postgres - TxManager - BeginTx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	conflict := &atomic.Bool{}
+	hooks := &[]func(){}
+	ctx = context.WithValue(context.WithValue(ctx, txKey{}, tx), conflictKey{}, conflict)
+	if err := fn(context.WithValue(ctx, commitKey{}, hooks)); err != nil {
+		return conflict.Load(), err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("postgres - TxManager - Commit: %w", err)
+	}
+
+	for _, hook := range *hooks {
+		hook()
+	}
+
+	return false, nil
+}
+
+// AfterCommit runs fn once the unit of work of ctx commits, fn is dropped if
+// the unit of work fails or is retried. Outside of a unit of work fn runs
+// right away, as the changes made with ctx are committed already.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(commitKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
+}
+
+// retryable reports whether the transaction failed only because of a concurrent one.
+func retryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgerrcode.SerializationFailure || pgErr.Code == pgerrcode.DeadlockDetected
+}
+
+// ParseIsoLevel parses an isolation level written in snake case, like "repeatable_read".
+func ParseIsoLevel(level string) (pgx.TxIsoLevel, error) {
+	switch level {
+	case "read_committed":
+		return pgx.ReadCommitted, nil
+	case "repeatable_read":
+		return pgx.RepeatableRead, nil
+	case "serializable":
+		return pgx.Serializable, nil
+	}
+	return "", fmt.Errorf("postgres - ParseIsoLevel: unknown isolation level %q", level)
+}
@@ -0,0 +1,40 @@
+package tracing
+
+type Option func(*Provider)
+
+// Exporter selects where spans go: ExporterOTLP, ExporterStdout or nowhere.
+// Without an exporter spans are still created, so trace ids are propagated and logged.
+func Exporter(exporter string) Option {
+	return func(p *Provider) {
+		p.exporter = exporter
+	}
+}
+
+// Endpoint is the host:port of the OTLP collector, OTEL_EXPORTER_OTLP_ENDPOINT is used by default.
+func Endpoint(endpoint string) Option {
+	return func(p *Provider) {
+		p.endpoint = endpoint
+	}
+}
+
+// Insecure sends spans to the OTLP collector without TLS.
+func Insecure(insecure bool) Option {
+	return func(p *Provider) {
+		p.insecure = insecure
+	}
+}
+
+// File makes the stdout exporter append spans to the file instead.
+func File(file string) Option {
+	return func(p *Provider) {
+		p.file = file
+	}
+}
+
+// SampleRatio is the share of traces started by the service that are sampled.
+// Traces started by callers follow their sampling decision.
+func SampleRatio(ratio float64) Option {
+	return func(p *Provider) {
+		p.sampleRatio = ratio
+	}
+}
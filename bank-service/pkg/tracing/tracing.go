@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Provider exports the spans of the process. It is installed as the global
+// tracer provider, so instrumented libraries pick it up on their own.
+type Provider struct {
+	exporter    string
+	endpoint    string
+	insecure    bool
+	file        string
+	sampleRatio float64
+
+	tp  *sdktrace.TracerProvider
+	out io.Closer
+}
+
+func New(ctx context.Context, serviceName string, opts ...Option) (*Provider, error) {
+	p := &Provider{
+		sampleRatio: 1,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch p.exporter {
+	case ExporterNone:
+	case ExporterOTLP:
+		exporterOpts := []otlptracegrpc.Option{}
+		if p.endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpoint(p.endpoint))
+		}
+		if p.insecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, exporterOpts...)
+	case ExporterStdout:
+		var w io.Writer = os.Stdout
+		if p.file != "" {
+			f, ferr := os.OpenFile(p.file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if ferr != nil {
+				return nil, fmt.Errorf("tracing - New - os.OpenFile: %w", ferr)
+			}
+			w, p.out = f, f
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w))
+	default:
+		return nil, fmt.Errorf("tracing - New: unknown exporter %q", p.exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing - New - %s exporter: %w", p.exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("tracing - New - resource.Merge: %w", err)
+	}
+
+	tpOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(p.sampleRatio))),
+	}
+	if exporter != nil {
+		tpOpts = append(tpOpts, sdktrace.WithBatcher(exporter))
+	}
+	p.tp = sdktrace.NewTracerProvider(tpOpts...)
+
+	otel.SetTracerProvider(p.tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return p, nil
+}
+
+// Shutdown flushes the spans not exported yet.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	err := p.tp.Shutdown(ctx)
+	if p.out != nil {
+		if cerr := p.out.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("tracing - Shutdown: %w", err)
+	}
+	return nil
+}
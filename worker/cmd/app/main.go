@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/d1mitrii/money-transfer/worker/internal/app"
+	"github.com/d1mitrii/money-transfer/worker/internal/config"
+)
+
+func main() {
+	cfg := config.MustLoad()
+	app.Run(cfg)
+}
@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	httpapp "github.com/d1mitrii/money-transfer/worker/internal/app/http"
+	"github.com/d1mitrii/money-transfer/worker/internal/config"
+	"github.com/d1mitrii/money-transfer/worker/internal/engine/local"
+	"github.com/d1mitrii/money-transfer/worker/internal/engine/temporal"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/repository/filedb"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"github.com/d1mitrii/money-transfer/worker/pkg/logger"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type engine interface {
+	Start(ctx context.Context, transfer models.Transfer) (models.Saga, error)
+	Get(ctx context.Context, transferUUID uuid.UUID) (models.Saga, error)
+	Run(ctx context.Context) error
+}
+
+func Run(cfg *config.Config) {
+	const op = "app - Run"
+
+	// Logger
+	log := logger.SetupLogger("local")
+
+	// Bank API
+	creds, err := bankCredentials(cfg.Bank.TLS)
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - bankCredentials: %v", op, err))
+		return
+	}
+
+	conn, err := grpc.NewClient(cfg.Bank.Address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(apiKeyInterceptor(cfg.Bank.APIKey)),
+	)
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - grpc.NewClient: %v", op, err))
+		return
+	}
+	defer conn.Close()
+
+	bank := bankv1.NewBankClient(conn)
+	activities := saga.NewActivities(bank, cfg.Bank.Timeout)
+	authorizer := saga.NewAuthorizer(bank, cfg.Bank.Timeout)
+	retry := saga.RetryPolicy{
+		MaxAttempts:     cfg.Saga.MaxAttempts,
+		InitialInterval: cfg.Saga.InitialInterval,
+		MaxInterval:     cfg.Saga.MaxInterval,
+	}
+
+	// Saga engine
+	var e engine
+	switch cfg.Engine {
+	case config.EngineTemporal:
+		e, err = temporal.New(
+			log,
+			cfg.Temporal.HostPort,
+			cfg.Temporal.Namespace,
+			cfg.Temporal.TaskQueue,
+			activities,
+			retry,
+			cfg.Bank.Timeout,
+		)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - temporal.New: %v", op, err))
+			return
+		}
+	case config.EngineLocal:
+		store, err := filedb.New(cfg.Local.StateDir)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s - filedb.New: %v", op, err))
+			return
+		}
+		e = local.New(log, store, activities, retry, cfg.Local.Workers)
+	default:
+		log.Error(fmt.Sprintf("%s - unknown engine %q", op, cfg.Engine))
+		return
+	}
+	log.Info("saga engine selected", slog.String("engine", cfg.Engine))
+
+	// http server
+	httpApp := httpapp.New(log, e, authorizer, cfg.HTTP.Port)
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+
+	// Run apps
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error { return e.Run(ctx) })
+	g.Go(httpApp.Run)
+	g.Go(func() error {
+		<-ctx.Done()
+		// Graceful shutdown
+		httpApp.Stop()
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		log.Error(fmt.Sprintf("%s - g.Wait: %v", op, err))
+	}
+}
+
+func bankCredentials(cfg config.BankTLSConfig) (credentials.TransportCredentials, error) {
+	if cfg.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	pem, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in %s", cfg.CAFile)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    roots,
+		ServerName: cfg.ServerName,
+	}
+
+	if cfg.CertFile != "" && cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// apiKeyInterceptor sends the API key with every call to the bank service,
+// except the calls made with the credentials of a caller of the worker.
+func apiKeyInterceptor(key string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if key != "" && len(md.Get("authorization")) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", key)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
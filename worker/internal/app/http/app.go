@@ -0,0 +1,69 @@
+package httpapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	transferhttp "github.com/d1mitrii/money-transfer/worker/internal/controller/http/transfer"
+)
+
+const _shutdownTimeout = 10 * time.Second
+
+type App struct {
+	log        *slog.Logger
+	httpServer *http.Server
+	port       int
+}
+
+func New(
+	log *slog.Logger,
+	engine transferhttp.Engine,
+	authorizer transferhttp.Authorizer,
+	port int,
+) *App {
+	mux := http.NewServeMux()
+	transferhttp.Register(mux, engine, authorizer)
+
+	return &App{
+		log: log,
+		httpServer: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+		port: port,
+	}
+}
+
+func (a *App) Run() error {
+	const op = "httpapp.App.Run"
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", a.port))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.log.Info("http server started", slog.Any("address", l.Addr().String()))
+
+	if err := a.httpServer.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *App) Stop() {
+	const op = "httpapp.App.Stop"
+	a.log.Info(fmt.Sprintf("%s - stopping http server", op))
+
+	ctx, cancel := context.WithTimeout(context.Background(), _shutdownTimeout)
+	defer cancel()
+
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		a.log.Error(fmt.Sprintf("%s - a.httpServer.Shutdown: %v", op, err))
+	}
+}
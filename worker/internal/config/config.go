@@ -0,0 +1,97 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+const (
+	EngineLocal    = "local"
+	EngineTemporal = "temporal"
+)
+
+type (
+	Config struct {
+		Engine   string         `env-default:"local" yaml:"engine" env:"WORKER_ENGINE"`
+		HTTP     HTTPConfig     `yaml:"http"`
+		Bank     BankConfig     `yaml:"bank"`
+		Saga     SagaConfig     `yaml:"saga"`
+		Local    LocalConfig    `yaml:"local"`
+		Temporal TemporalConfig `yaml:"temporal"`
+	}
+
+	HTTPConfig struct {
+		Port int `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+	}
+
+	BankConfig struct {
+		Address string        `env-required:"true" yaml:"address" env:"BANK_ADDRESS"`
+		Timeout time.Duration `env-default:"5s" yaml:"timeout" env:"BANK_TIMEOUT"`
+		// APIKey authenticates the worker at the bank service.
+		APIKey string        `yaml:"api_key" env:"BANK_API_KEY"`
+		TLS    BankTLSConfig `yaml:"tls"`
+	}
+
+	// BankTLSConfig enables TLS towards the bank service if CAFile is set.
+	// The client certificate is only sent if both its files are set.
+	BankTLSConfig struct {
+		CAFile     string `yaml:"ca_file" env:"BANK_TLS_CA_FILE"`
+		CertFile   string `yaml:"cert_file" env:"BANK_TLS_CERT_FILE"`
+		KeyFile    string `yaml:"key_file" env:"BANK_TLS_KEY_FILE"`
+		ServerName string `yaml:"server_name" env:"BANK_TLS_SERVER_NAME"`
+	}
+
+	// SagaConfig controls how individual saga steps are retried. MaxAttempts
+	// bounds the withdrawal and the deposit, the refund is retried until it
+	// succeeds or is rejected for good.
+	SagaConfig struct {
+		MaxAttempts     int           `env-default:"5" yaml:"max_attempts" env:"SAGA_MAX_ATTEMPTS"`
+		InitialInterval time.Duration `env-default:"1s" yaml:"initial_interval" env:"SAGA_INITIAL_INTERVAL"`
+		MaxInterval     time.Duration `env-default:"1m" yaml:"max_interval" env:"SAGA_MAX_INTERVAL"`
+	}
+
+	// LocalConfig configures the in-process engine used when no Temporal server is available.
+	LocalConfig struct {
+		StateDir string `env-default:"./state" yaml:"state_dir" env:"LOCAL_STATE_DIR"`
+		Workers  int    `env-default:"4" yaml:"workers" env:"LOCAL_WORKERS"`
+	}
+
+	TemporalConfig struct {
+		HostPort  string `yaml:"host_port" env:"TEMPORAL_HOST_PORT"`
+		Namespace string `env-default:"default" yaml:"namespace" env:"TEMPORAL_NAMESPACE"`
+		TaskQueue string `env-default:"transfer" yaml:"task_queue" env:"TEMPORAL_TASK_QUEUE"`
+	}
+)
+
+func MustLoad() *Config {
+	var cfg Config
+	path := fetchConfigPath()
+	if path != "" {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			panic("config file doesn't exist: " + path)
+		}
+		if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+			panic("failed to read config file: " + err.Error())
+		}
+		return &cfg
+	}
+
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		panic("failed to read configuration from env")
+	}
+
+	return &cfg
+}
+
+func fetchConfigPath() string {
+	var result string
+	flag.StringVar(&result, "config", "", "path to config file")
+	flag.Parse()
+	if result == "" {
+		result = os.Getenv("CONFIG_PATH")
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package transferhttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/google/uuid"
+)
+
+type Engine interface {
+	Start(ctx context.Context, transfer models.Transfer) (models.Saga, error)
+	Get(ctx context.Context, transferUUID uuid.UUID) (models.Saga, error)
+}
+
+// Authorizer checks that the caller with the authorization header may start the transfer.
+type Authorizer interface {
+	AuthorizeTransfer(ctx context.Context, authorization string, transfer models.Transfer) error
+}
+
+type transferAPI struct {
+	engine     Engine
+	authorizer Authorizer
+}
+
+func Register(mux *http.ServeMux, engine Engine, authorizer Authorizer) {
+	api := &transferAPI{
+		engine:     engine,
+		authorizer: authorizer,
+	}
+
+	mux.HandleFunc("POST /transfers", api.StartTransfer)
+	mux.HandleFunc("GET /transfers/{uuid}", api.GetTransfer)
+}
@@ -0,0 +1,136 @@
+package transferhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/engine"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"github.com/google/uuid"
+)
+
+type startTransferRequest struct {
+	// UUID is optional, a client may set it to make retries of the same request idempotent.
+	UUID            string `json:"uuid"`
+	FromAccountUUID string `json:"from_account_uuid"`
+	ToAccountUUID   string `json:"to_account_uuid"`
+	Amount          int64  `json:"amount"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (t *transferAPI) StartTransfer(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, "missing credentials")
+		return
+	}
+
+	var in startTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request body")
+		return
+	}
+
+	transferUUID := uuid.New()
+	if in.UUID != "" {
+		var err error
+		if transferUUID, err = uuid.Parse(in.UUID); err != nil {
+			writeError(w, http.StatusBadRequest, "incorrect format of uuid")
+			return
+		}
+	}
+
+	from, err := uuid.Parse(in.FromAccountUUID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "incorrect format of from_account_uuid")
+		return
+	}
+
+	to, err := uuid.Parse(in.ToAccountUUID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "incorrect format of to_account_uuid")
+		return
+	}
+
+	if from == to {
+		writeError(w, http.StatusBadRequest, "source and target accounts must differ")
+		return
+	}
+
+	if in.Amount <= 0 {
+		writeError(w, http.StatusBadRequest, "incorrect amount")
+		return
+	}
+
+	transfer := models.Transfer{
+		UUID:            transferUUID,
+		FromAccountUUID: from,
+		ToAccountUUID:   to,
+		Amount:          in.Amount,
+	}
+
+	if err := t.authorizer.AuthorizeTransfer(r.Context(), authorization, transfer); err != nil {
+		switch {
+		case errors.Is(err, saga.ErrUnauthenticated):
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "invalid credentials")
+		case errors.Is(err, saga.ErrForbidden):
+			writeError(w, http.StatusForbidden, "source account is not owned by caller")
+		case errors.Is(err, saga.ErrAccountNotFound):
+			writeError(w, http.StatusNotFound, "account not found")
+		case errors.Is(err, saga.ErrConflict):
+			writeError(w, http.StatusConflict, "transfer exists with other details")
+		default:
+			writeError(w, http.StatusBadGateway, "bank error")
+		}
+		return
+	}
+
+	started, err := t.engine.Start(r.Context(), transfer)
+	if err != nil {
+		if errors.Is(err, engine.ErrAlreadyStarted) {
+			writeError(w, http.StatusConflict, "transfer already started")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "engine error")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, started)
+}
+
+func (t *transferAPI) GetTransfer(w http.ResponseWriter, r *http.Request) {
+	transferUUID, err := uuid.Parse(r.PathValue("uuid"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "incorrect format of uuid")
+		return
+	}
+
+	saga, err := t.engine.Get(r.Context(), transferUUID)
+	if err != nil {
+		if errors.Is(err, engine.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "transfer not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "engine error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, saga)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, errorResponse{Error: msg})
+}
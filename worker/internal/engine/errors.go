@@ -0,0 +1,8 @@
+package engine
+
+import "errors"
+
+var (
+	ErrAlreadyStarted = errors.New("transfer already started")
+	ErrNotFound       = errors.New("transfer not found")
+)
@@ -0,0 +1,280 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/engine"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/repository/repoerr"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"github.com/google/uuid"
+)
+
+const _queueSize = 1024
+
+type SagaStore interface {
+	CreateSaga(ctx context.Context, saga models.Saga) error
+	SaveSaga(ctx context.Context, saga models.Saga) error
+	GetSaga(ctx context.Context, transferUUID uuid.UUID) (models.Saga, error)
+	ListUnfinished(ctx context.Context) ([]models.Saga, error)
+}
+
+// Engine runs transfer sagas in-process. The saga state is persisted after
+// every step and unfinished sagas are picked up again by Run, so a crash
+// resumes from the last recorded step. A step interrupted by the crash is
+// executed once more; the bank recognises the repeated step by the transfer
+// UUID and does not move the money twice.
+type Engine struct {
+	log        *slog.Logger
+	store      SagaStore
+	activities *saga.Activities
+	retry      saga.RetryPolicy
+	workers    int
+	queue      chan uuid.UUID
+}
+
+func New(
+	log *slog.Logger,
+	store SagaStore,
+	activities *saga.Activities,
+	retry saga.RetryPolicy,
+	workers int,
+) *Engine {
+	return &Engine{
+		log:        log,
+		store:      store,
+		activities: activities,
+		retry:      retry,
+		workers:    max(workers, 1),
+		queue:      make(chan uuid.UUID, _queueSize),
+	}
+}
+
+func (e *Engine) Start(ctx context.Context, transfer models.Transfer) (models.Saga, error) {
+	const op = "local.Engine.Start"
+
+	now := time.Now().UTC()
+	s := models.Saga{
+		Transfer:  transfer,
+		Status:    models.SagaPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := e.store.CreateSaga(ctx, s); err != nil {
+		if errors.Is(err, repoerr.ErrAlreadyExist) {
+			return models.Saga{}, engine.ErrAlreadyStarted
+		}
+		return models.Saga{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	select {
+	case e.queue <- transfer.UUID:
+	case <-ctx.Done():
+		// The saga is persisted, it will be resumed on the next start.
+	}
+
+	return s, nil
+}
+
+func (e *Engine) Get(ctx context.Context, transferUUID uuid.UUID) (models.Saga, error) {
+	const op = "local.Engine.Get"
+
+	s, err := e.store.GetSaga(ctx, transferUUID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return models.Saga{}, engine.ErrNotFound
+		}
+		return models.Saga{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s, nil
+}
+
+// Run resumes unfinished sagas and executes new ones until ctx is cancelled.
+func (e *Engine) Run(ctx context.Context) error {
+	const op = "local.Engine.Run"
+
+	unfinished, err := e.store.ListUnfinished(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	e.log.Info("local saga engine started",
+		slog.Int("workers", e.workers),
+		slog.Int("resumed", len(unfinished)),
+	)
+
+	var wg sync.WaitGroup
+	for range e.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case id := <-e.queue:
+					e.execute(ctx, id)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		for _, s := range unfinished {
+			select {
+			case e.queue <- s.Transfer.UUID:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	return nil
+}
+
+func (e *Engine) execute(ctx context.Context, transferUUID uuid.UUID) {
+	log := e.log.With(slog.String("transferUUID", transferUUID.String()))
+
+	s, err := e.store.GetSaga(ctx, transferUUID)
+	if err != nil {
+		log.Error("failed to load saga", slog.Any("err", err))
+		return
+	}
+
+	for !s.Status.Terminal() {
+		next, err := e.step(ctx, s)
+		if err != nil {
+			// Interrupted by shutdown, the current step is retried after restart.
+			return
+		}
+
+		next.UpdatedAt = time.Now().UTC()
+		if err := e.store.SaveSaga(ctx, next); err != nil {
+			log.Error("failed to save saga", slog.Any("err", err))
+			return
+		}
+
+		log.Info("saga step finished",
+			slog.String("from", string(s.Status)),
+			slog.String("to", string(next.Status)),
+			slog.String("reason", next.Reason),
+		)
+		s = next
+	}
+}
+
+// step performs the action due in the current status and returns the saga in its next status.
+// An error is only returned when ctx was cancelled before the step could be decided.
+func (e *Engine) step(ctx context.Context, s models.Saga) (models.Saga, error) {
+	switch s.Status {
+	case models.SagaPending:
+		if err := e.attempt(ctx, e.activities.Withdraw, s.Transfer, false); err != nil {
+			if ctx.Err() != nil {
+				return s, ctx.Err()
+			}
+			return e.fail(ctx, s, models.SagaFailed, models.SagaDebited, fmt.Sprintf("withdraw: %v", err))
+		}
+		return transition(s, models.SagaDebited, ""), nil
+
+	case models.SagaDebited:
+		if err := e.attempt(ctx, e.activities.Deposit, s.Transfer, false); err != nil {
+			if ctx.Err() != nil {
+				return s, ctx.Err()
+			}
+			return e.fail(ctx, s, models.SagaCompensating, models.SagaCompleted, fmt.Sprintf("deposit: %v", err))
+		}
+		return transition(s, models.SagaCompleted, ""), nil
+
+	case models.SagaCompensating:
+		// The money has already left the source account, so keep trying until
+		// the refund either succeeds or is rejected for good.
+		if err := e.attempt(ctx, e.activities.Refund, s.Transfer, true); err != nil {
+			if ctx.Err() != nil {
+				return s, ctx.Err()
+			}
+			return e.fail(ctx, s, models.SagaFailed, models.SagaRefunded, fmt.Sprintf("%s; refund: %v", s.Reason, err))
+		}
+		return transition(s, models.SagaRefunded, s.Reason), nil
+	}
+
+	return s, nil
+}
+
+// fail records a failed step on the bank side before the saga moves on,
+// so the transfer status seen by bank clients follows the saga. If the bank
+// has applied the step after all, the saga moves on to applied instead.
+func (e *Engine) fail(
+	ctx context.Context,
+	s models.Saga,
+	to, applied models.SagaStatus,
+	reason string,
+) (models.Saga, error) {
+	record := func(ctx context.Context, transfer models.Transfer) error {
+		return e.activities.Fail(ctx, transfer, to, reason)
+	}
+
+	if err := e.attempt(ctx, record, s.Transfer, true); err != nil {
+		if ctx.Err() != nil {
+			return s, ctx.Err()
+		}
+		if saga.IsApplied(err) {
+			return transition(s, applied, s.Reason), nil
+		}
+		e.log.Error("failed to record transfer failure",
+			slog.String("transferUUID", s.Transfer.UUID.String()),
+			slog.Any("err", err),
+		)
+	}
+
+	return transition(s, to, reason), nil
+}
+
+// attempt runs the activity until it succeeds, fails on a final error, ctx is
+// done or, unless unlimited, the attempts run out. A temporary error says nothing
+// about whether the bank has applied the step, fail asks the bank about it.
+func (e *Engine) attempt(
+	ctx context.Context,
+	activity func(context.Context, models.Transfer) error,
+	transfer models.Transfer,
+	unlimited bool,
+) error {
+	for attempt := 1; ; attempt++ {
+		err := activity(ctx, transfer)
+		if err == nil {
+			return nil
+		}
+
+		if !saga.IsRetryable(err) || (!unlimited && attempt >= e.retry.MaxAttempts) {
+			return err
+		}
+
+		delay := e.retry.Backoff(attempt)
+		e.log.Warn("saga step failed, retrying",
+			slog.String("transferUUID", transfer.UUID.String()),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.Any("err", err),
+		)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func transition(s models.Saga, status models.SagaStatus, reason string) models.Saga {
+	s.Status = status
+	s.Reason = reason
+	return s
+}
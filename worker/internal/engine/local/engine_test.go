@@ -0,0 +1,287 @@
+package local_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/d1mitrii/money-transfer/worker/internal/engine/local"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/repository/filedb"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const _maxAttempts = 3
+
+var (
+	errUnavailable = status.Error(codes.Unavailable, "connection refused")
+	errIllegal     = status.Error(codes.FailedPrecondition, "illegal transfer status transition")
+)
+
+// step scripts the answers of the bank to one kind of movement.
+type step struct {
+	// errs are returned by the first attempts, the later ones succeed.
+	errs []error
+	// applied makes the bank move the money even though it answers with an
+	// error, as if the answer got lost on the way back.
+	applied bool
+}
+
+// bank follows the transfer status of the bank service: a movement is only
+// applied in the status it is due in and a failure only recorded before it.
+type bank struct {
+	bankv1.BankClient
+
+	mu                        sync.Mutex
+	status                    string
+	withdraw, deposit, refund step
+	calls                     map[string]int
+}
+
+func (b *bank) move(name string, s step, from, to string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls[name]++
+	var err error
+	if n := b.calls[name]; n <= len(s.errs) {
+		err = s.errs[n-1]
+	}
+	if err != nil && !s.applied {
+		return err
+	}
+
+	switch b.status {
+	case from:
+		b.status = to
+	case to:
+		// A replayed movement.
+	default:
+		return errIllegal
+	}
+	return err
+}
+
+func (b *bank) CreateTransfer(context.Context, *bankv1.CreateTransferRequest, ...grpc.CallOption) (*bankv1.Transfer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.status == "" {
+		b.status = "pending"
+	}
+	return &bankv1.Transfer{}, nil
+}
+
+func (b *bank) Withdraw(context.Context, *bankv1.WithdrawRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, b.move("withdraw", b.withdraw, "pending", "debited")
+}
+
+func (b *bank) Deposit(context.Context, *bankv1.DepositRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, b.move("deposit", b.deposit, "debited", "completed")
+}
+
+func (b *bank) Refund(context.Context, *bankv1.RefundRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, b.move("refund", b.refund, "compensating", "refunded")
+}
+
+func (b *bank) FailTransfer(_ context.Context, in *bankv1.FailTransferRequest, _ ...grpc.CallOption) (*bankv1.Transfer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	to := "failed"
+	if in.GetStatus() == bankv1.TransferStatus_TRANSFER_STATUS_COMPENSATING {
+		to = "compensating"
+	}
+
+	switch {
+	case b.status == "":
+		return nil, status.Error(codes.NotFound, "transfer not found")
+	case b.status == to,
+		b.status == "pending" && to == "failed",
+		b.status == "debited" && to == "compensating",
+		b.status == "compensating" && to == "failed":
+		b.status = to
+		return &bankv1.Transfer{}, nil
+	}
+	return nil, errIllegal
+}
+
+func (b *bank) count(name string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.calls[name]
+}
+
+func unavailable(n int) []error {
+	errs := make([]error, n)
+	for i := range errs {
+		errs[i] = errUnavailable
+	}
+	return errs
+}
+
+func TestSaga(t *testing.T) {
+	tests := []struct {
+		name                      string
+		withdraw, deposit, refund step
+		want                      models.SagaStatus
+		// bankStatus is the transfer status on the bank side at the end.
+		bankStatus string
+		// calls counts the attempts of every movement.
+		calls map[string]int
+	}{
+		{
+			name:       "completed",
+			want:       models.SagaCompleted,
+			bankStatus: "completed",
+			calls:      map[string]int{"withdraw": 1, "deposit": 1},
+		},
+		{
+			name:       "withdrawal rejected",
+			withdraw:   step{errs: []error{status.Error(codes.FailedPrecondition, "insufficient funds")}},
+			want:       models.SagaFailed,
+			bankStatus: "failed",
+			calls:      map[string]int{"withdraw": 1},
+		},
+		{
+			name:       "withdrawal retried",
+			withdraw:   step{errs: unavailable(_maxAttempts - 1)},
+			want:       models.SagaCompleted,
+			bankStatus: "completed",
+			calls:      map[string]int{"withdraw": _maxAttempts, "deposit": 1},
+		},
+		{
+			name:       "withdrawal given up",
+			withdraw:   step{errs: unavailable(_maxAttempts)},
+			want:       models.SagaFailed,
+			bankStatus: "failed",
+			calls:      map[string]int{"withdraw": _maxAttempts},
+		},
+		{
+			name:       "withdrawal given up after the bank applied it",
+			withdraw:   step{errs: unavailable(_maxAttempts), applied: true},
+			want:       models.SagaCompleted,
+			bankStatus: "completed",
+			calls:      map[string]int{"withdraw": _maxAttempts, "deposit": 1},
+		},
+		{
+			name:       "deposit rejected",
+			deposit:    step{errs: []error{status.Error(codes.NotFound, "account not found")}},
+			want:       models.SagaRefunded,
+			bankStatus: "refunded",
+			calls:      map[string]int{"withdraw": 1, "deposit": 1, "refund": 1},
+		},
+		{
+			name:       "deposit given up",
+			deposit:    step{errs: unavailable(_maxAttempts)},
+			want:       models.SagaRefunded,
+			bankStatus: "refunded",
+			calls:      map[string]int{"withdraw": 1, "deposit": _maxAttempts, "refund": 1},
+		},
+		{
+			name:       "deposit given up after the bank applied it",
+			deposit:    step{errs: unavailable(_maxAttempts), applied: true},
+			want:       models.SagaCompleted,
+			bankStatus: "completed",
+			calls:      map[string]int{"withdraw": 1, "deposit": _maxAttempts},
+		},
+		{
+			name:       "refund retried past the attempts of the other steps",
+			deposit:    step{errs: []error{status.Error(codes.NotFound, "account not found")}},
+			refund:     step{errs: unavailable(2 * _maxAttempts)},
+			want:       models.SagaRefunded,
+			bankStatus: "refunded",
+			calls:      map[string]int{"withdraw": 1, "deposit": 1, "refund": 2*_maxAttempts + 1},
+		},
+		{
+			name:       "refund rejected",
+			deposit:    step{errs: []error{status.Error(codes.NotFound, "account not found")}},
+			refund:     step{errs: []error{status.Error(codes.NotFound, "account not found")}},
+			want:       models.SagaFailed,
+			bankStatus: "failed",
+			calls:      map[string]int{"withdraw": 1, "deposit": 1, "refund": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bank{
+				withdraw: tt.withdraw,
+				deposit:  tt.deposit,
+				refund:   tt.refund,
+				calls:    make(map[string]int),
+			}
+
+			s := run(t, b)
+
+			if s.Status != tt.want {
+				t.Errorf("saga: got %s (%s), want %s", s.Status, s.Reason, tt.want)
+			}
+			if b.status != tt.bankStatus {
+				t.Errorf("bank: got %s, want %s", b.status, tt.bankStatus)
+			}
+			for _, name := range []string{"withdraw", "deposit", "refund"} {
+				if got := b.count(name); got != tt.calls[name] {
+					t.Errorf("%s attempts: got %d, want %d", name, got, tt.calls[name])
+				}
+			}
+		})
+	}
+}
+
+// run executes a saga against the bank and returns it once it has finished.
+func run(t *testing.T, b *bank) models.Saga {
+	t.Helper()
+
+	store, err := filedb.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("filedb.New: %v", err)
+	}
+
+	e := local.New(slog.New(slog.NewTextHandler(io.Discard, nil)), store, saga.NewActivities(b, time.Second), saga.RetryPolicy{
+		MaxAttempts:     _maxAttempts,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+	}, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = e.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	transfer := models.Transfer{UUID: uuid.New(), FromAccountUUID: uuid.New(), ToAccountUUID: uuid.New(), Amount: 30}
+	if _, err := e.Start(ctx, transfer); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	for {
+		s, err := e.Get(ctx, transfer.UUID)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if s.Status.Terminal() {
+			return s
+		}
+
+		select {
+		case <-time.After(time.Millisecond):
+		case <-ctx.Done():
+			t.Fatalf("saga not finished, last status %s", s.Status)
+		}
+	}
+}
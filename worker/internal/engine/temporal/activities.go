@@ -0,0 +1,43 @@
+package temporal
+
+import (
+	"context"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/status"
+)
+
+// Activities adapts the saga steps to Temporal, marking final Bank API errors as non-retryable.
+type Activities struct {
+	steps *saga.Activities
+}
+
+func (a *Activities) Withdraw(ctx context.Context, transfer models.Transfer) error {
+	return convert(a.steps.Withdraw(ctx, transfer))
+}
+
+func (a *Activities) Deposit(ctx context.Context, transfer models.Transfer) error {
+	return convert(a.steps.Deposit(ctx, transfer))
+}
+
+func (a *Activities) Refund(ctx context.Context, transfer models.Transfer) error {
+	return convert(a.steps.Refund(ctx, transfer))
+}
+
+func (a *Activities) Fail(ctx context.Context, transfer models.Transfer, to models.SagaStatus, reason string) error {
+	return convert(a.steps.Fail(ctx, transfer, to, reason))
+}
+
+func convert(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st := status.Convert(err)
+	if !saga.IsRetryable(err) {
+		return temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), nil)
+	}
+	return temporal.NewApplicationError(st.Message(), st.Code().String())
+}
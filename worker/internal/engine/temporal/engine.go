@@ -0,0 +1,133 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/engine"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	temporallog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+)
+
+// Engine runs transfer sagas as Temporal workflows.
+type Engine struct {
+	log         *slog.Logger
+	client      client.Client
+	worker      worker.Worker
+	taskQueue   string
+	retry       saga.RetryPolicy
+	stepTimeout time.Duration
+}
+
+func New(
+	log *slog.Logger,
+	hostPort string,
+	namespace string,
+	taskQueue string,
+	activities *saga.Activities,
+	retry saga.RetryPolicy,
+	stepTimeout time.Duration,
+) (*Engine, error) {
+	const op = "temporal.New"
+
+	c, err := client.Dial(client.Options{
+		HostPort:  hostPort,
+		Namespace: namespace,
+		Logger:    temporallog.NewStructuredLogger(log),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s - client.Dial: %w", op, err)
+	}
+
+	w := worker.New(c, taskQueue, worker.Options{})
+	w.RegisterWorkflow(TransferWorkflow)
+	w.RegisterActivity(&Activities{steps: activities})
+
+	return &Engine{
+		log:         log,
+		client:      c,
+		worker:      w,
+		taskQueue:   taskQueue,
+		retry:       retry,
+		stepTimeout: stepTimeout,
+	}, nil
+}
+
+func (e *Engine) Start(ctx context.Context, transfer models.Transfer) (models.Saga, error) {
+	const op = "temporal.Engine.Start"
+
+	opts := client.StartWorkflowOptions{
+		ID:                                       transfer.UUID.String(),
+		TaskQueue:                                e.taskQueue,
+		WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}
+
+	_, err := e.client.ExecuteWorkflow(ctx, opts, TransferWorkflow, TransferInput{
+		Transfer:    transfer,
+		Retry:       e.retry,
+		StepTimeout: e.stepTimeout,
+	})
+	if err != nil {
+		var started *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &started) {
+			return models.Saga{}, engine.ErrAlreadyStarted
+		}
+		return models.Saga{}, fmt.Errorf("%s - e.client.ExecuteWorkflow: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	return models.Saga{
+		Transfer:  transfer,
+		Status:    models.SagaPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (e *Engine) Get(ctx context.Context, transferUUID uuid.UUID) (models.Saga, error) {
+	const op = "temporal.Engine.Get"
+
+	value, err := e.client.QueryWorkflow(ctx, transferUUID.String(), "", QueryState)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return models.Saga{}, engine.ErrNotFound
+		}
+		return models.Saga{}, fmt.Errorf("%s - e.client.QueryWorkflow: %w", op, err)
+	}
+
+	var s models.Saga
+	if err := value.Get(&s); err != nil {
+		return models.Saga{}, fmt.Errorf("%s - value.Get: %w", op, err)
+	}
+
+	return s, nil
+}
+
+// Run polls the task queue until ctx is cancelled.
+func (e *Engine) Run(ctx context.Context) error {
+	const op = "temporal.Engine.Run"
+
+	if err := e.worker.Start(); err != nil {
+		return fmt.Errorf("%s - e.worker.Start: %w", op, err)
+	}
+
+	e.log.Info("temporal worker started", slog.String("task queue", e.taskQueue))
+
+	<-ctx.Done()
+
+	e.worker.Stop()
+	e.client.Close()
+
+	return nil
+}
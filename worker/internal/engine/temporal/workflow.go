@@ -0,0 +1,123 @@
+package temporal
+
+import (
+	"errors"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/grpc/codes"
+)
+
+const QueryState = "state"
+
+type TransferInput struct {
+	Transfer    models.Transfer
+	Retry       saga.RetryPolicy
+	StepTimeout time.Duration
+}
+
+// TransferWorkflow withdraws from the source account and deposits to the target one.
+// A failed deposit is compensated by refunding the source account. A step given up
+// on temporary errors may have been applied by the bank, which then refuses its
+// failure and the workflow moves on.
+// Temporal persists the workflow history, so a restarted worker replays it and
+// continues where it stopped.
+func TransferWorkflow(ctx workflow.Context, in TransferInput) (models.Saga, error) {
+	now := workflow.Now(ctx)
+	state := models.Saga{
+		Transfer:  in.Transfer,
+		Status:    models.SagaPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := workflow.SetQueryHandler(ctx, QueryState, func() (models.Saga, error) {
+		return state, nil
+	}); err != nil {
+		return state, err
+	}
+
+	transition := func(status models.SagaStatus, reason string) {
+		state.Status = status
+		state.Reason = reason
+		state.UpdatedAt = workflow.Now(ctx)
+	}
+
+	retry := &temporal.RetryPolicy{
+		InitialInterval:    in.Retry.InitialInterval,
+		BackoffCoefficient: 2,
+		MaximumInterval:    in.Retry.MaxInterval,
+		MaximumAttempts:    int32(in.Retry.MaxAttempts),
+	}
+	stepCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: in.StepTimeout,
+		RetryPolicy:         retry,
+	})
+
+	// The refund and the recorded failures are retried until they are rejected
+	// for good, the final errors are marked non-retryable by the activities.
+	compensationRetry := *retry
+	compensationRetry.MaximumAttempts = 0
+	compensationCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: in.StepTimeout,
+		RetryPolicy:         &compensationRetry,
+	})
+
+	var a *Activities
+
+	// fail records a failed step on the bank side, so the transfer status seen
+	// by bank clients follows the workflow. If the bank has applied the step
+	// after all, the workflow moves on to applied instead.
+	fail := func(status, applied models.SagaStatus, why string) {
+		err := workflow.ExecuteActivity(compensationCtx, a.Fail, in.Transfer, status, why).Get(ctx, nil)
+		if isApplied(err) {
+			transition(applied, state.Reason)
+			return
+		}
+		transition(status, why)
+	}
+
+	if err := workflow.ExecuteActivity(stepCtx, a.Withdraw, in.Transfer).Get(ctx, nil); err != nil {
+		fail(models.SagaFailed, models.SagaDebited, "withdraw: "+reason(err))
+	} else {
+		transition(models.SagaDebited, "")
+	}
+	if state.Status != models.SagaDebited {
+		return state, nil
+	}
+
+	if err := workflow.ExecuteActivity(stepCtx, a.Deposit, in.Transfer).Get(ctx, nil); err != nil {
+		fail(models.SagaCompensating, models.SagaCompleted, "deposit: "+reason(err))
+	} else {
+		transition(models.SagaCompleted, "")
+	}
+	if state.Status != models.SagaCompensating {
+		return state, nil
+	}
+
+	if err := workflow.ExecuteActivity(compensationCtx, a.Refund, in.Transfer).Get(ctx, nil); err != nil {
+		fail(models.SagaFailed, models.SagaRefunded, state.Reason+"; refund: "+reason(err))
+		return state, nil
+	}
+	transition(models.SagaRefunded, state.Reason)
+
+	return state, nil
+}
+
+func reason(err error) string {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return appErr.Error()
+	}
+	return err.Error()
+}
+
+// isApplied is saga.IsApplied for the errors of the Temporal activities,
+// which carry the gRPC code as their type.
+func isApplied(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == codes.FailedPrecondition.String()
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Transfer struct {
+	UUID            uuid.UUID `json:"uuid"`
+	FromAccountUUID uuid.UUID `json:"from_account_uuid"`
+	ToAccountUUID   uuid.UUID `json:"to_account_uuid"`
+	Amount          int64     `json:"amount"`
+}
+
+type SagaStatus string
+
+const (
+	// SagaPending means nothing has been withdrawn yet.
+	SagaPending SagaStatus = "pending"
+	// SagaDebited means the source account was debited and the deposit is outstanding.
+	SagaDebited SagaStatus = "debited"
+	// SagaCompensating means the deposit failed and the withdrawal is being refunded.
+	SagaCompensating SagaStatus = "compensating"
+	SagaCompleted    SagaStatus = "completed"
+	SagaRefunded     SagaStatus = "refunded"
+	SagaFailed       SagaStatus = "failed"
+)
+
+// Terminal reports whether the saga has nothing left to do.
+func (s SagaStatus) Terminal() bool {
+	switch s {
+	case SagaCompleted, SagaRefunded, SagaFailed:
+		return true
+	}
+	return false
+}
+
+type Saga struct {
+	Transfer  Transfer   `json:"transfer"`
+	Status    SagaStatus `json:"status"`
+	Reason    string     `json:"reason,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
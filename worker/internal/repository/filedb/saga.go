@@ -0,0 +1,169 @@
+package filedb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"github.com/d1mitrii/money-transfer/worker/internal/repository/repoerr"
+	"github.com/google/uuid"
+)
+
+const sagaExt = ".json"
+
+// SagaRepo keeps one JSON document per saga in a directory. Every write goes
+// through a temporary file and a rename, so a crash never leaves a torn state
+// behind: after a restart each saga is either at its previous or its next step.
+type SagaRepo struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func New(dir string) (*SagaRepo, error) {
+	const op = "filedb.New"
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("%s - os.MkdirAll: %w", op, err)
+	}
+
+	return &SagaRepo{dir: dir}, nil
+}
+
+func (s *SagaRepo) CreateSaga(_ context.Context, saga models.Saga) error {
+	const op = "SagaRepo.CreateSaga"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := os.Stat(s.path(saga.Transfer.UUID)); err == nil {
+		return repoerr.ErrAlreadyExist
+	}
+
+	if err := s.write(saga); err != nil {
+		return fmt.Errorf("%s - s.write: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SagaRepo) SaveSaga(_ context.Context, saga models.Saga) error {
+	const op = "SagaRepo.SaveSaga"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(saga); err != nil {
+		return fmt.Errorf("%s - s.write: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SagaRepo) GetSaga(_ context.Context, transferUUID uuid.UUID) (models.Saga, error) {
+	const op = "SagaRepo.GetSaga"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saga, err := s.read(s.path(transferUUID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return models.Saga{}, repoerr.ErrNotFound
+		}
+		return models.Saga{}, fmt.Errorf("%s - s.read: %w", op, err)
+	}
+
+	return saga, nil
+}
+
+// ListUnfinished returns every saga that has not reached a terminal status.
+func (s *SagaRepo) ListUnfinished(_ context.Context) ([]models.Saga, error) {
+	const op = "SagaRepo.ListUnfinished"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s - os.ReadDir: %w", op, err)
+	}
+
+	var sagas []models.Saga
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), sagaExt) {
+			continue
+		}
+
+		saga, err := s.read(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%s - s.read: %w", op, err)
+		}
+
+		if !saga.Status.Terminal() {
+			sagas = append(sagas, saga)
+		}
+	}
+
+	return sagas, nil
+}
+
+func (s *SagaRepo) path(transferUUID uuid.UUID) string {
+	return filepath.Join(s.dir, transferUUID.String()+sagaExt)
+}
+
+func (s *SagaRepo) read(path string) (models.Saga, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.Saga{}, err
+	}
+
+	var saga models.Saga
+	if err := json.Unmarshal(data, &saga); err != nil {
+		return models.Saga{}, fmt.Errorf("decode %s: %w", path, err)
+	}
+
+	return saga, nil
+}
+
+func (s *SagaRepo) write(saga models.Saga) error {
+	data, err := json.Marshal(saga)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "saga-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(saga.Transfer.UUID)); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(s.dir)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
@@ -0,0 +1,8 @@
+package repoerr
+
+import "errors"
+
+var (
+	ErrAlreadyExist = errors.New("already exists")
+	ErrNotFound     = errors.New("not found")
+)
@@ -0,0 +1,114 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Activities are the individual saga steps, each of them a single call to the Bank API.
+type Activities struct {
+	bank    bankv1.BankClient
+	timeout time.Duration
+}
+
+func NewActivities(bank bankv1.BankClient, timeout time.Duration) *Activities {
+	return &Activities{
+		bank:    bank,
+		timeout: timeout,
+	}
+}
+
+// Withdraw registers the transfer with the bank and debits the source account.
+// Both calls are idempotent for the same transfer, so the step is safe to repeat.
+func (a *Activities) Withdraw(ctx context.Context, transfer models.Transfer) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.bank.CreateTransfer(ctx, &bankv1.CreateTransferRequest{
+		TransferUUID:    transfer.UUID.String(),
+		FromAccountUUID: transfer.FromAccountUUID.String(),
+		ToAccountUUID:   transfer.ToAccountUUID.String(),
+		Amount:          transfer.Amount,
+	}); err != nil {
+		return err
+	}
+
+	_, err := a.bank.Withdraw(ctx, &bankv1.WithdrawRequest{
+		AccountUUID:  transfer.FromAccountUUID.String(),
+		Amount:       transfer.Amount,
+		TransferUUID: transfer.UUID.String(),
+	})
+	return err
+}
+
+func (a *Activities) Deposit(ctx context.Context, transfer models.Transfer) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	_, err := a.bank.Deposit(ctx, &bankv1.DepositRequest{
+		AccountUUID:  transfer.ToAccountUUID.String(),
+		Amount:       transfer.Amount,
+		TransferUUID: transfer.UUID.String(),
+	})
+	return err
+}
+
+// Refund compensates a successful Withdraw by returning the amount to the source account.
+func (a *Activities) Refund(ctx context.Context, transfer models.Transfer) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	_, err := a.bank.Refund(ctx, &bankv1.RefundRequest{
+		AccountUUID:  transfer.FromAccountUUID.String(),
+		Amount:       transfer.Amount,
+		TransferUUID: transfer.UUID.String(),
+	})
+	return err
+}
+
+// Fail records a failed step on the bank side, moving the transfer to
+// compensating or failed. A transfer the bank never registered is ignored.
+// The bank refuses the failure of a step it has applied after all, as its
+// transfer has moved on, see IsApplied.
+func (a *Activities) Fail(ctx context.Context, transfer models.Transfer, to models.SagaStatus, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	st := bankv1.TransferStatus_TRANSFER_STATUS_FAILED
+	if to == models.SagaCompensating {
+		st = bankv1.TransferStatus_TRANSFER_STATUS_COMPENSATING
+	}
+
+	_, err := a.bank.FailTransfer(ctx, &bankv1.FailTransferRequest{
+		TransferUUID: transfer.UUID.String(),
+		Status:       st,
+		Reason:       reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+// IsApplied reports whether Fail was refused because the bank has applied the
+// failed step, the saga then moves on as if the step succeeded.
+func IsApplied(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition
+}
+
+// IsRetryable reports whether a failed step may succeed if it is attempted again.
+// Validation and not-found errors are final, everything else is treated as transient.
+func IsRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.FailedPrecondition, codes.PermissionDenied, codes.Unauthenticated,
+		codes.Unimplemented, codes.OutOfRange:
+		return false
+	}
+	return true
+}
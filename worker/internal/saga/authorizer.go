@@ -0,0 +1,64 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	bankv1 "github.com/d1mitrii/money-transfer/bank-service/pkg/grpc/bank/v1"
+	"github.com/d1mitrii/money-transfer/worker/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUnauthenticated = errors.New("caller is not authenticated")
+	ErrForbidden       = errors.New("caller does not own the source account")
+	ErrAccountNotFound = errors.New("account not found")
+	ErrConflict        = errors.New("transfer exists with other details")
+)
+
+// Authorizer holds the callers of the worker to the authentication and account
+// ownership rules of the bank service, before a saga moves money on their behalf.
+type Authorizer struct {
+	bank    bankv1.BankClient
+	timeout time.Duration
+}
+
+func NewAuthorizer(bank bankv1.BankClient, timeout time.Duration) *Authorizer {
+	return &Authorizer{
+		bank:    bank,
+		timeout: timeout,
+	}
+}
+
+// AuthorizeTransfer registers the transfer with the bank with the authorization
+// header of the caller. The bank only accepts it from a caller that may move
+// money from the source account, and the saga registering it again is a no-op.
+func (a *Authorizer) AuthorizeTransfer(ctx context.Context, authorization string, transfer models.Transfer) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	_, err := a.bank.CreateTransfer(ctx, &bankv1.CreateTransferRequest{
+		TransferUUID:    transfer.UUID.String(),
+		FromAccountUUID: transfer.FromAccountUUID.String(),
+		ToAccountUUID:   transfer.ToAccountUUID.String(),
+		Amount:          transfer.Amount,
+	})
+
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.Unauthenticated:
+		return ErrUnauthenticated
+	case codes.PermissionDenied:
+		return ErrForbidden
+	case codes.NotFound:
+		return ErrAccountNotFound
+	case codes.AlreadyExists:
+		return ErrConflict
+	}
+	return err
+}
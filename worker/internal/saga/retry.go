@@ -0,0 +1,26 @@
+package saga
+
+import "time"
+
+// RetryPolicy spaces the attempts of a saga step. A withdrawal or deposit failing
+// on temporary errors is given up after MaxAttempts, the refund and the recorded
+// failures are attempted until they succeed or fail for good. A step given up may
+// still have been applied by the bank, see Activities.Fail.
+type RetryPolicy struct {
+	MaxAttempts     int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// Backoff returns the delay before the given attempt (counting from 1),
+// doubling the initial interval each time up to MaxInterval.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialInterval
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.MaxInterval {
+			return p.MaxInterval
+		}
+	}
+	return delay
+}
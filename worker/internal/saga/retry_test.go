@@ -0,0 +1,59 @@
+package saga_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/d1mitrii/money-transfer/worker/internal/saga"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	p := saga.RetryPolicy{InitialInterval: time.Second, MaxInterval: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 4, want: 5 * time.Second},
+		{attempt: 100, want: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := p.Backoff(tt.attempt); got != tt.want {
+			t.Errorf("Backoff(%d): got %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestStepErrors(t *testing.T) {
+	tests := []struct {
+		err       error
+		retryable bool
+		applied   bool
+	}{
+		{err: status.Error(codes.Unavailable, "connection refused"), retryable: true},
+		{err: status.Error(codes.DeadlineExceeded, "deadline exceeded"), retryable: true},
+		{err: status.Error(codes.ResourceExhausted, "rate limit exceeded"), retryable: true},
+		{err: status.Error(codes.Internal, "internal error"), retryable: true},
+		{err: errors.New("not a status"), retryable: true},
+		{err: status.Error(codes.InvalidArgument, "amount must be positive")},
+		{err: status.Error(codes.NotFound, "account not found")},
+		{err: status.Error(codes.PermissionDenied, "method is not allowed")},
+		{err: status.Error(codes.FailedPrecondition, "illegal transfer status transition"), applied: true},
+	}
+
+	for _, tt := range tests {
+		if got := saga.IsRetryable(tt.err); got != tt.retryable {
+			t.Errorf("IsRetryable(%v): got %v, want %v", tt.err, got, tt.retryable)
+		}
+		if got := saga.IsApplied(tt.err); got != tt.applied {
+			t.Errorf("IsApplied(%v): got %v, want %v", tt.err, got, tt.applied)
+		}
+	}
+}